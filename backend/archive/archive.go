@@ -0,0 +1,227 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SchemaVersion - Archive formatı değişirse artırılır (import tarafı kontrol eder)
+const SchemaVersion = 1
+
+// Archive içindeki dosya düzeni
+const (
+	ManifestName = "manifest.json"
+	PostsDir     = "posts"
+	ProjectsDir  = "projects"
+	SkillsDir    = "skills"
+)
+
+// UploadDirs - Archive klasörü -> sunucudaki upload klasörü
+// Archive klasör isimleri public URL prefix'leri ile aynı (/uploads/x.png -> uploads/x.png)
+var UploadDirs = map[string]string{
+	"uploads":       "./uploads",
+	"skills-upload": "./skills-upload",
+	"blog-upload":   "./blog-upload",
+}
+
+// Manifest - Archive içeriği ve checksum'lar
+type Manifest struct {
+	SchemaVersion int            `json:"schema_version"`
+	GeneratedAt   time.Time      `json:"generated_at"`
+	Counts        map[string]int `json:"counts"`
+	Files         []FileEntry    `json:"files"`
+}
+
+// FileEntry - Archive'daki tek dosya
+type FileEntry struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Writer - Zip'e yazarken her dosyanın checksum'ını tutar, Close'da manifest ekler
+type Writer struct {
+	zip     *zip.Writer
+	entries []FileEntry
+	counts  map[string]int
+}
+
+// NewWriter - Stream'e zip yazan writer oluştur
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		zip:    zip.NewWriter(w),
+		counts: make(map[string]int),
+	}
+}
+
+// AddFile - Bellekteki içeriği archive'a ekle
+func (w *Writer) AddFile(name string, data []byte, modTime time.Time) error {
+	return w.add(name, modTime, bytes.NewReader(data))
+}
+
+// AddFromDisk - Diskteki dosyayı archive'a stream et
+func (w *Writer) AddFromDisk(name, diskPath string) error {
+	file, err := os.Open(diskPath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", diskPath, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", diskPath, err)
+	}
+
+	return w.add(name, info.ModTime(), file)
+}
+
+// Count - Manifest'teki entity sayaçları (posts, projects, skills...)
+func (w *Writer) Count(kind string) {
+	w.counts[kind]++
+}
+
+// Close - Manifest'i yaz ve zip'i kapat
+func (w *Writer) Close() error {
+	manifest := Manifest{
+		SchemaVersion: SchemaVersion,
+		GeneratedAt:   time.Now().UTC(),
+		Counts:        w.counts,
+		Files:         w.entries,
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	header := &zip.FileHeader{Name: ManifestName, Method: zip.Deflate, Modified: manifest.GeneratedAt}
+	fw, err := w.zip.CreateHeader(header)
+	if err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if _, err := fw.Write(manifestJSON); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return w.zip.Close()
+}
+
+func (w *Writer) add(name string, modTime time.Time, r io.Reader) error {
+	header := &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modTime}
+	fw, err := w.zip.CreateHeader(header)
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(fw, hash), r)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	w.entries = append(w.entries, FileEntry{
+		Path:   name,
+		Size:   size,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	})
+
+	return nil
+}
+
+// ListUploads - Upload klasörlerindeki dosyalar (archive path -> disk path)
+// Alt klasörler de dahil (uploads/gallery/<id>/x.png), eksik klasörler ve gizli dosyalar atlanır
+func ListUploads() (map[string]string, error) {
+	files := make(map[string]string)
+
+	for archiveDir, diskDir := range UploadDirs {
+		err := filepath.WalkDir(diskDir, func(diskPath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if diskPath == diskDir && errors.Is(err, fs.ErrNotExist) {
+					return filepath.SkipDir
+				}
+				return err
+			}
+			if diskPath == diskDir {
+				return nil
+			}
+			if strings.HasPrefix(entry.Name(), ".") {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if entry.IsDir() {
+				return nil
+			}
+
+			rel, err := filepath.Rel(diskDir, diskPath)
+			if err != nil {
+				return err
+			}
+			files[path.Join(archiveDir, filepath.ToSlash(rel))] = diskPath
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", diskDir, err)
+		}
+	}
+
+	return files, nil
+}
+
+// SortedKeys - Deterministik archive sırası için
+func SortedKeys(files map[string]string) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// uploadRefPattern - Upload klasörlerine işaret eden URL'ler
+// "/uploads/x.png", "http://localhost:8082/skills-upload/react.svg" vb.
+var uploadRefPattern = regexp.MustCompile(`(?:https?://[^/\s"'()<>]+)?/(uploads|skills-upload|blog-upload)/([^\s"'()<>?#]+)`)
+
+// RewriteToArchive - Archive'a dahil edilen dosyalara yapılan referansları relative path'e çevir
+// prefix: dosyanın archive kökünden uzaklığı (posts/x.md için "../")
+// Archive'da olmayan dosyalara yapılan referanslar olduğu gibi kalır
+func RewriteToArchive(text, prefix string, included map[string]string) string {
+	return uploadRefPattern.ReplaceAllStringFunc(text, func(match string) string {
+		sub := uploadRefPattern.FindStringSubmatch(match)
+		archivePath := path.Join(sub[1], sub[2])
+		if _, ok := included[archivePath]; !ok {
+			return match
+		}
+		return prefix + archivePath
+	})
+}
+
+// SafeName - Entity ID'sinden dosya adı üret ("project:404 Squad" -> "404-squad")
+func SafeName(id string) string {
+	if i := strings.Index(id, ":"); i >= 0 {
+		id = id[i+1:]
+	}
+
+	name := strings.ToLower(id)
+	name = regexp.MustCompile(`[^a-z0-9_-]+`).ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+	if name == "" {
+		name = "item"
+	}
+	return name
+}
@@ -0,0 +1,211 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriterManifestRoundTrip(t *testing.T) {
+	files := []struct {
+		name string
+		kind string
+		data string
+	}{
+		{"posts/hello.md", "posts", "---\ntitle: Hello\n---\n\n![c](../blog-upload/c.png)\n"},
+		{"projects/site.yaml", "projects", "title: Site\n"},
+		{"skills/go.yaml", "skills", "skill: Go\n"},
+		{"blog-upload/c.png", "files", "\x89PNG fake"},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, f := range files {
+		if err := w.AddFile(f.name, []byte(f.data), time.Now()); err != nil {
+			t.Fatalf("AddFile(%s): %v", f.name, err)
+		}
+		w.Count(f.kind)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+	manifest, err := ReadManifest(zr)
+	if err != nil || manifest == nil {
+		t.Fatalf("ReadManifest = %v, %v", manifest, err)
+	}
+	if manifest.SchemaVersion != SchemaVersion || len(manifest.Files) != len(files) {
+		t.Fatalf("manifest = version %d with %d files, want %d with %d", manifest.SchemaVersion, len(manifest.Files), SchemaVersion, len(files))
+	}
+	for _, kind := range []string{"posts", "projects", "skills", "files"} {
+		if manifest.Counts[kind] != 1 {
+			t.Errorf("counts[%s] = %d, want 1", kind, manifest.Counts[kind])
+		}
+	}
+
+	// Her entry okunabilmeli ve checksum'ı tutmalı
+	for _, f := range zr.File {
+		if f.Name == ManifestName {
+			continue
+		}
+		data, err := ReadEntry(f)
+		if err != nil {
+			t.Fatalf("ReadEntry(%s): %v", f.Name, err)
+		}
+		if err := manifest.VerifyChecksum(f.Name, data); err != nil {
+			t.Errorf("VerifyChecksum(%s): %v", f.Name, err)
+		}
+		if err := manifest.VerifyChecksum(f.Name, append(data, '!')); err == nil {
+			t.Errorf("VerifyChecksum(%s) accepted modified data", f.Name)
+		}
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string // Boşsa archive'da manifest yok
+		wantErr  string
+		wantNil  bool
+	}{
+		{name: "no manifest", wantNil: true},
+		{name: "current version", manifest: `{"schema_version": 1}`},
+		{name: "older version", manifest: `{"schema_version": 0}`},
+		{name: "newer version", manifest: `{"schema_version": 2}`, wantErr: "newer than supported"},
+		{name: "invalid json", manifest: `{`, wantErr: "failed to parse manifest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			zw := zip.NewWriter(&buf)
+			fw, _ := zw.Create("posts/a.md")
+			fw.Write([]byte("# A"))
+			if tt.manifest != "" {
+				fw, _ := zw.Create("./" + ManifestName) // CleanPath ile normalize edilip bulunmalı
+				fw.Write([]byte(tt.manifest))
+			}
+			zw.Close()
+
+			zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				t.Fatalf("zip.NewReader: %v", err)
+			}
+			manifest, err := ReadManifest(zr)
+			switch {
+			case tt.wantErr != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ReadManifest error = %v, want %q", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Errorf("ReadManifest: %v", err)
+			case (manifest == nil) != tt.wantNil:
+				t.Errorf("ReadManifest = %+v, want nil: %v", manifest, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestReadEntryLimit(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, _ := zw.Create("blog-upload/huge.png")
+	fw.Write(make([]byte, MaxEntrySize+1)) // Sıfırlar iyi sıkışır, zip küçük kalır
+	zw.Close()
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+	if _, err := ReadEntry(zr.File[0]); err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Errorf("ReadEntry error = %v, want size limit error", err)
+	}
+}
+
+func TestSafeName(t *testing.T) {
+	tests := map[string]string{
+		"project:404 Squad":    "404-squad",
+		"skill:Frontend:React": "frontend-react",
+		"blog:hello-world":     "hello-world",
+		"project:!!!":          "item",
+	}
+	for id, want := range tests {
+		if got := SafeName(id); got != want {
+			t.Errorf("SafeName(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+// Manifest'in JSON alan adları archive formatının parçası, eski archive'lar okunabilmeli
+func TestManifestJSONFields(t *testing.T) {
+	data, _ := json.Marshal(Manifest{SchemaVersion: 1, Files: []FileEntry{{Path: "a", Size: 1, SHA256: "x"}}})
+	for _, field := range []string{`"schema_version"`, `"generated_at"`, `"counts"`, `"files"`, `"sha256"`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("manifest JSON %s has no %s", data, field)
+		}
+	}
+}
+
+func TestListUploads(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	files := []string{
+		"uploads/project-site-main.png",
+		"uploads/gallery/project-site/img-1.png",
+		"uploads/gallery/project-site/img-2.webp",
+		"uploads/gallery/project-blog/deep/nested/img.svg",
+		"blog-upload/cover.jpg",
+		// Gizli dosya ve klasörler atlanır
+		"uploads/.DS_Store",
+		"uploads/gallery/.cache/img.png",
+		// Upload klasörü dışındakiler atlanır
+		"other/x.png",
+	}
+	for _, name := range files {
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// skills-upload hiç yok - hata değil
+
+	got, err := ListUploads()
+	if err != nil {
+		t.Fatalf("ListUploads: %v", err)
+	}
+
+	want := map[string]string{
+		"uploads/project-site-main.png":                    filepath.Join("uploads", "project-site-main.png"),
+		"uploads/gallery/project-site/img-1.png":           filepath.Join("uploads", "gallery", "project-site", "img-1.png"),
+		"uploads/gallery/project-site/img-2.webp":          filepath.Join("uploads", "gallery", "project-site", "img-2.webp"),
+		"uploads/gallery/project-blog/deep/nested/img.svg": filepath.Join("uploads", "gallery", "project-blog", "deep", "nested", "img.svg"),
+		"blog-upload/cover.jpg":                            filepath.Join("blog-upload", "cover.jpg"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUploads =\n%v\nwant\n%v", got, want)
+	}
+	// Disk path'leri okunabilir olmalı (export bunları stream eder)
+	for archivePath, diskPath := range got {
+		if data, err := os.ReadFile(diskPath); err != nil || filepath.ToSlash(string(data)) != archivePath {
+			t.Errorf("%s -> %s: %q, %v", archivePath, diskPath, data, err)
+		}
+	}
+
+	keys := SortedKeys(got)
+	if !sort.StringsAreSorted(keys) || len(keys) != len(want) {
+		t.Errorf("SortedKeys = %v", keys)
+	}
+}
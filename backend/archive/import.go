@@ -0,0 +1,166 @@
+package archive
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// MaxEntrySize - Tek bir archive dosyasının açılmış hali için üst sınır
+const MaxEntrySize = 20 << 20
+
+// Import modları - mevcut entity ile çakışmada ne yapılacak
+const (
+	ModeSkip      = "skip"      // Mevcut kalır
+	ModeOverwrite = "overwrite" // Archive'daki hali ile değiştirilir
+	ModeMerge     = "merge"     // Archive'da dolu olan alanlar mevcudun üzerine yazılır
+)
+
+// IsValidMode - Import modu destekleniyor mu?
+func IsValidMode(mode string) bool {
+	return mode == ModeSkip || mode == ModeOverwrite || mode == ModeMerge
+}
+
+// imageExts - Upload handler'ı ile aynı izinli uzantılar
+var imageExts = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".svg":  true,
+	".webp": true,
+}
+
+// IsImage - Dosya uzantısı izinli resim mi?
+func IsImage(name string) bool {
+	return imageExts[strings.ToLower(path.Ext(name))]
+}
+
+// CleanPath - Zip entry adını normalize et, güvensiz veya gereksiz entry'ler için "" döner
+func CleanPath(name string) string {
+	name = path.Clean(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.TrimPrefix(name, "/")
+
+	if name == "." || strings.HasPrefix(name, "../") || name == ".." {
+		return ""
+	}
+	if strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), ".") {
+		return ""
+	}
+	return name
+}
+
+// ReadEntry - Zip entry'sini boyut sınırı ile oku
+func ReadEntry(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, MaxEntrySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", f.Name, err)
+	}
+	if len(data) > MaxEntrySize {
+		return nil, fmt.Errorf("%s exceeds %d bytes", f.Name, MaxEntrySize)
+	}
+
+	return data, nil
+}
+
+// ReadManifest - Archive'da manifest varsa oku ve schema version'ı kontrol et
+// Manifest opsiyonel - elle hazırlanmış zip'lerde olmayabilir
+func ReadManifest(zr *zip.Reader) (*Manifest, error) {
+	for _, f := range zr.File {
+		if CleanPath(f.Name) != ManifestName {
+			continue
+		}
+
+		data, err := ReadEntry(f)
+		if err != nil {
+			return nil, err
+		}
+
+		var manifest Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse manifest: %w", err)
+		}
+		if manifest.SchemaVersion > SchemaVersion {
+			return nil, fmt.Errorf("archive schema version %d is newer than supported version %d", manifest.SchemaVersion, SchemaVersion)
+		}
+		return &manifest, nil
+	}
+
+	return nil, nil
+}
+
+// VerifyChecksum - Manifest'te kayıtlı dosyanın checksum'ı tutuyor mu?
+func (m *Manifest) VerifyChecksum(name string, data []byte) error {
+	if m == nil {
+		return nil
+	}
+
+	for _, entry := range m.Files {
+		if entry.Path != name {
+			continue
+		}
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != entry.SHA256 {
+			return fmt.Errorf("checksum mismatch for %s", name)
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// refTokenPattern - Markdown/YAML içindeki path benzeri token'lar
+var refTokenPattern = regexp.MustCompile(`[^\s"'()<>\[\]]+`)
+
+// ResolveRefs - Archive içi relative referansları public URL'lere çevir
+// fileDir: referansı içeren dosyanın archive içindeki klasörü ("posts")
+// targets: archive path -> public URL ("blog-upload/a.png" -> "/blog-upload/a.png")
+func ResolveRefs(text, fileDir string, targets map[string]string) string {
+	if len(targets) == 0 {
+		return text
+	}
+
+	return refTokenPattern.ReplaceAllStringFunc(text, func(token string) string {
+		if strings.Contains(token, "://") || strings.HasPrefix(token, "data:") {
+			return token
+		}
+
+		resolved := path.Clean(path.Join(fileDir, token))
+		if url, ok := targets[resolved]; ok {
+			return url
+		}
+		return token
+	})
+}
+
+// MergeNonZero - src'deki dolu alanları dst'nin üzerine yaz (ID hariç)
+// dst ve src aynı struct tipine pointer olmalı
+func MergeNonZero(dst, src interface{}) {
+	dv := reflect.ValueOf(dst).Elem()
+	sv := reflect.ValueOf(src).Elem()
+
+	for i := 0; i < dv.NumField(); i++ {
+		if dv.Type().Field(i).Name == "ID" || !dv.Field(i).CanSet() {
+			continue
+		}
+		if field := sv.Field(i); !field.IsZero() {
+			if field.Kind() == reflect.Slice && field.Len() == 0 {
+				continue
+			}
+			dv.Field(i).Set(field)
+		}
+	}
+}
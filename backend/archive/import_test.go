@@ -0,0 +1,115 @@
+package archive
+
+import (
+	"testing"
+)
+
+func TestCleanPath(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"posts/hello.md", "posts/hello.md"},
+		{"posts/./hello.md", "posts/hello.md"},
+		{"posts//hello.md", "posts/hello.md"},
+		{"/uploads/a.png", "uploads/a.png"},
+		{"uploads\\gallery\\a.png", "uploads/gallery/a.png"},
+		{"uploads/gallery/../a.png", "uploads/a.png"},
+
+		// Archive kökünün dışına çıkan entry'ler
+		{"../evil.png", ""},
+		{"..", ""},
+		{"uploads/../../evil.png", ""},
+		{"a/b/../../../etc/passwd", ""},
+		{"..\\..\\evil.png", ""},
+		{"/../evil.png", "evil.png"}, // Kökten yukarısı yok, "/.." kök olarak kalır
+
+		// Gereksiz entry'ler
+		{"", ""},
+		{".", ""},
+		{"__MACOSX/posts/._hello.md", ""},
+		{"posts/.DS_Store", ""},
+		{".hidden/x.png", ".hidden/x.png"}, // Sadece dosya adı kontrol edilir
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanPath(tt.name); got != tt.want {
+				t.Errorf("CleanPath(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRewriteAndResolveRefs(t *testing.T) {
+	included := map[string]string{
+		"blog-upload/cover.png":      "./blog-upload/cover.png",
+		"uploads/gallery/p1/one.png": "./uploads/gallery/p1/one.png",
+	}
+	// Import tarafında archive path -> public URL
+	targets := map[string]string{
+		"blog-upload/cover.png":      "/blog-upload/cover.png",
+		"uploads/gallery/p1/one.png": "/uploads/gallery/p1/one.png",
+	}
+
+	tests := []struct {
+		name     string
+		original string
+		archived string
+		restored string
+	}{
+		{
+			name:     "markdown image",
+			original: "![cover](/blog-upload/cover.png)",
+			archived: "![cover](../blog-upload/cover.png)",
+			restored: "![cover](/blog-upload/cover.png)",
+		},
+		{
+			name:     "absolute backend URL",
+			original: `<img src="http://localhost:8082/uploads/gallery/p1/one.png">`,
+			archived: `<img src="../uploads/gallery/p1/one.png">`,
+			restored: `<img src="/uploads/gallery/p1/one.png">`,
+		},
+		{
+			name:     "file not in archive",
+			original: "![missing](/blog-upload/missing.png)",
+			archived: "![missing](/blog-upload/missing.png)",
+			restored: "![missing](/blog-upload/missing.png)",
+		},
+		{
+			name:     "upload path on another host",
+			original: "![x](https://cdn.example.com/blog-upload/cover.png)",
+			archived: "![x](../blog-upload/cover.png)",
+			restored: "![x](/blog-upload/cover.png)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archived := RewriteToArchive(tt.original, "../", included)
+			if archived != tt.archived {
+				t.Errorf("RewriteToArchive = %q, want %q", archived, tt.archived)
+			}
+			if restored := ResolveRefs(archived, PostsDir, targets); restored != tt.restored {
+				t.Errorf("ResolveRefs = %q, want %q", restored, tt.restored)
+			}
+		})
+	}
+}
+
+func TestMergeNonZero(t *testing.T) {
+	type entity struct {
+		ID    string
+		Title string
+		Tags  []string
+		Count int
+	}
+
+	dst := &entity{ID: "a", Title: "Old", Tags: []string{"x"}, Count: 3}
+	MergeNonZero(dst, &entity{ID: "b", Title: "New", Tags: []string{}})
+
+	want := entity{ID: "a", Title: "New", Tags: []string{"x"}, Count: 3}
+	if dst.ID != want.ID || dst.Title != want.Title || len(dst.Tags) != 1 || dst.Count != want.Count {
+		t.Errorf("MergeNonZero = %+v, want %+v", *dst, want)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"sort"
+
+	"portfolio-backend/config"
+	"portfolio-backend/handlers"
+)
+
+// Git content backup CLI (BACKUP_GIT_DIR)
+// go run ./backup                         -> içeriği yaz ve commit et
+// go run ./backup -log 20                 -> backup geçmişi
+// go run ./backup -restore <commit> [-prune] -> commit'i Redis'e geri yükle
+func main() {
+	restore := flag.String("restore", "", "Commit (hash, HEAD~1, tag...) to load back into Redis")
+	prune := flag.Bool("prune", false, "With -restore: delete posts/projects/skills that are not in the commit")
+	logLimit := flag.Int("log", 0, "List the last N backup commits")
+	flag.Parse()
+
+	cfg := config.LoadConfig()
+	if cfg.Backup.GitDir == "" {
+		log.Fatal("BACKUP_GIT_DIR is not set")
+	}
+
+	err := config.InitRedis(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize Redis:", err)
+	}
+	defer config.CloseRedis()
+
+	handler := handlers.NewBackupHandler(cfg, config.GetRedisClient())
+
+	switch {
+	case *logLimit > 0:
+		commits, err := handler.Log(*logLimit)
+		if err != nil {
+			log.Fatal("Failed to read backup history:", err)
+		}
+		for _, commit := range commits {
+			fmt.Printf("%s  %s  %s\n", commit.Hash[:7], commit.Date.Format("2006-01-02 15:04"), commit.Subject)
+		}
+
+	case *restore != "":
+		fmt.Println("🚀 Content Restore Started")
+		fmt.Println("====================================================")
+
+		report, err := handler.Restore(*restore, *prune)
+		if err != nil {
+			log.Fatal("Restore failed:", err)
+		}
+
+		fmt.Printf("📦 Commit: %s\n\n", report.Commit)
+		for _, item := range report.Items {
+			line := fmt.Sprintf("  [%s] %s %s", item.Action, item.Type, item.ID)
+			if item.Error != "" {
+				line += " ❌ " + item.Error
+			}
+			fmt.Println(line)
+		}
+
+		fmt.Println("")
+		fmt.Println("📊 Summary:")
+		for _, action := range sortedKeys(report.Summary) {
+			fmt.Printf("  %s: %d\n", action, report.Summary[action])
+		}
+
+		fmt.Println("")
+		fmt.Println("🎉 Content Restore Completed!")
+		fmt.Println("====================================================")
+
+	default:
+		result, err := handler.Run()
+		if err != nil {
+			log.Fatal("Backup failed:", err)
+		}
+		if result.Commit == "" {
+			fmt.Println("✅ " + result.Message)
+			return
+		}
+		fmt.Printf("✅ %s\n%s", result.Commit[:7], result.Message)
+	}
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
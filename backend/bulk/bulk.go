@@ -0,0 +1,174 @@
+package bulk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"portfolio-backend/models"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Desteklenen formatlar
+const (
+	FormatYAML = "yaml"
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// Formats - Geçerli format listesi (hata mesajları için)
+var Formats = []string{FormatYAML, FormatJSON, FormatCSV}
+
+// ContentTypes - Export response'ları için
+var ContentTypes = map[string]string{
+	FormatYAML: "application/yaml; charset=utf-8",
+	FormatJSON: "application/json; charset=utf-8",
+	FormatCSV:  "text/csv; charset=utf-8",
+}
+
+// DetectFormat - Açık format, yoksa dosya uzantısı, o da yoksa content type
+func DetectFormat(format, filename, contentType string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "yml" {
+		format = FormatYAML
+	}
+
+	if format == "" {
+		switch strings.ToLower(path.Ext(filename)) {
+		case ".yaml", ".yml":
+			format = FormatYAML
+		case ".json":
+			format = FormatJSON
+		case ".csv":
+			format = FormatCSV
+		}
+	}
+
+	if format == "" {
+		switch {
+		case strings.Contains(contentType, "yaml"):
+			format = FormatYAML
+		case strings.Contains(contentType, "json"):
+			format = FormatJSON
+		case strings.Contains(contentType, "csv"):
+			format = FormatCSV
+		}
+	}
+
+	for _, valid := range Formats {
+		if format == valid {
+			return format, nil
+		}
+	}
+	if format == "" {
+		return "", fmt.Errorf("format could not be detected (use format=%s)", strings.Join(Formats, "|"))
+	}
+	return "", fmt.Errorf("unsupported format: %s", format)
+}
+
+// EncodeProjects - Projeleri verilen formatta yaz
+func EncodeProjects(w io.Writer, format string, projects []models.Project) error {
+	switch format {
+	case FormatCSV:
+		return writeProjectsCSV(w, projects)
+	default:
+		return encode(w, format, projects)
+	}
+}
+
+// DecodeProjects - Projeleri verilen formattan oku
+// JSON/YAML'da hem düz liste hem de {"projects": [...]} (V1 migrate body'si) kabul edilir
+func DecodeProjects(r io.Reader, format string) ([]models.Project, error) {
+	if format == FormatCSV {
+		return readProjectsCSV(r)
+	}
+
+	var wrapped struct {
+		Projects []models.Project `json:"projects" yaml:"projects"`
+	}
+	var projects []models.Project
+	if err := decode(r, format, &projects, &wrapped); err != nil {
+		return nil, err
+	}
+	if projects == nil {
+		projects = wrapped.Projects
+	}
+	return projects, nil
+}
+
+// EncodeSkills - Skill'leri verilen formatta yaz
+func EncodeSkills(w io.Writer, format string, skills []models.Skill) error {
+	switch format {
+	case FormatCSV:
+		return writeSkillsCSV(w, skills)
+	default:
+		return encode(w, format, skills)
+	}
+}
+
+// DecodeSkills - Skill'leri verilen formattan oku
+// JSON/YAML'da hem düz liste hem de {"skills": [...]} kabul edilir
+func DecodeSkills(r io.Reader, format string) ([]models.Skill, error) {
+	if format == FormatCSV {
+		return readSkillsCSV(r)
+	}
+
+	var wrapped struct {
+		Skills []models.Skill `json:"skills" yaml:"skills"`
+	}
+	var skills []models.Skill
+	if err := decode(r, format, &skills, &wrapped); err != nil {
+		return nil, err
+	}
+	if skills == nil {
+		skills = wrapped.Skills
+	}
+	return skills, nil
+}
+
+func encode(w io.Writer, format string, value interface{}) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+		return encoder.Close()
+	}
+	return fmt.Errorf("unsupported format: %s", format)
+}
+
+// decode - Önce liste olarak, olmazsa wrapper object olarak dene
+func decode(r io.Reader, format string, list, wrapped interface{}) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read input: %w", err)
+	}
+
+	var unmarshal func([]byte, interface{}) error
+	switch format {
+	case FormatJSON:
+		unmarshal = json.Unmarshal
+	case FormatYAML:
+		unmarshal = yaml.Unmarshal
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+
+	listErr := unmarshal(data, list)
+	if listErr == nil {
+		return nil
+	}
+	if err := unmarshal(data, wrapped); err != nil {
+		return fmt.Errorf("%s parsing failed: %w", format, listErr)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package bulk
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"portfolio-backend/models"
+)
+
+// CSV kolonları - view_count ve updated_at içerik değil, CSV'ye yazılmaz
+// Proje body'si ve galeri CSV'de yok, import'ta mevcut değerleri korunur
+var (
+	ProjectColumns = []string{"id", "title", "description", "link", "image", "status", "createdAt", "featured", "pinned", "tools", "slug", "role", "repoUrl", "liveUrl", "start", "end"}
+	SkillColumns   = []string{"id", "category", "skill", "icon", "proficiency", "years", "firstUsed", "currentlyUsing"}
+)
+
+// Tools hücresi: "React|/uploads/react.png;Go|" (skill|icon, ; ile ayrılmış)
+const (
+	toolSeparator     = ";"
+	toolIconSeparator = "|"
+)
+
+func writeProjectsCSV(w io.Writer, projects []models.Project) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(ProjectColumns); err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		record := []string{
+			project.ID,
+			project.Title,
+			project.Description,
+			project.Link,
+			project.Image,
+			project.Status,
+			project.CreatedAt,
+			strconv.FormatBool(project.Featured),
+			strconv.FormatBool(project.Pinned),
+			formatTools(project.Tools),
+			project.Slug,
+			project.Role,
+			project.RepoURL,
+			project.LiveURL,
+		}
+		if project.Timeframe != nil {
+			record = append(record, project.Timeframe.Start, project.Timeframe.End)
+		} else {
+			record = append(record, "", "")
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func readProjectsCSV(r io.Reader) ([]models.Project, error) {
+	rows, err := readCSV(r, ProjectColumns, "title")
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]models.Project, 0, len(rows))
+	for i, row := range rows {
+		featured, err := parseBool(row, "featured", i)
+		if err != nil {
+			return nil, err
+		}
+		pinned, err := parseBool(row, "pinned", i)
+		if err != nil {
+			return nil, err
+		}
+
+		var timeframe *models.ProjectTimeframe
+		if row["start"] != "" || row["end"] != "" {
+			timeframe = &models.ProjectTimeframe{Start: row["start"], End: row["end"]}
+		}
+
+		projects = append(projects, models.Project{
+			ID:          row["id"],
+			Title:       row["title"],
+			Description: row["description"],
+			Link:        row["link"],
+			Image:       row["image"],
+			Status:      row["status"],
+			CreatedAt:   row["createdAt"],
+			Featured:    featured,
+			Pinned:      pinned,
+			Tools:       parseTools(row["tools"]),
+			Slug:        row["slug"],
+			Role:        row["role"],
+			RepoURL:     row["repoUrl"],
+			LiveURL:     row["liveUrl"],
+			Timeframe:   timeframe,
+		})
+	}
+	return projects, nil
+}
+
+func writeSkillsCSV(w io.Writer, skills []models.Skill) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(SkillColumns); err != nil {
+		return err
+	}
+
+	for _, skill := range skills {
+		// 0 değerler boş hücre (belirtilmemiş)
+		proficiency, years := "", ""
+		if skill.Proficiency != 0 {
+			proficiency = strconv.Itoa(skill.Proficiency)
+		}
+		if skill.Years != 0 {
+			years = strconv.FormatFloat(skill.Years, 'f', -1, 64)
+		}
+
+		record := []string{
+			skill.ID,
+			skill.Category,
+			skill.Skill,
+			skill.Icon,
+			proficiency,
+			years,
+			skill.FirstUsed,
+			strconv.FormatBool(skill.CurrentlyUsing),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func readSkillsCSV(r io.Reader) ([]models.Skill, error) {
+	rows, err := readCSV(r, SkillColumns, "category", "skill")
+	if err != nil {
+		return nil, err
+	}
+
+	skills := make([]models.Skill, 0, len(rows))
+	for i, row := range rows {
+		currentlyUsing, err := parseBool(row, "currentlyUsing", i)
+		if err != nil {
+			return nil, err
+		}
+		proficiency, years := 0, 0.0
+		if value := row["proficiency"]; value != "" {
+			if proficiency, err = strconv.Atoi(value); err != nil {
+				return nil, fmt.Errorf("row %d: invalid proficiency value: %s", i+2, value)
+			}
+		}
+		if value := row["years"]; value != "" {
+			if years, err = strconv.ParseFloat(value, 64); err != nil {
+				return nil, fmt.Errorf("row %d: invalid years value: %s", i+2, value)
+			}
+		}
+
+		skills = append(skills, models.Skill{
+			ID:             row["id"],
+			Category:       row["category"],
+			Skill:          row["skill"],
+			Icon:           row["icon"],
+			Proficiency:    proficiency,
+			Years:          years,
+			FirstUsed:      row["firstUsed"],
+			CurrentlyUsing: currentlyUsing,
+		})
+	}
+	return skills, nil
+}
+
+// readCSV - Header satırına göre satırları map'e çevir
+// Kolon sırası serbest, bilinmeyen kolon hata, required kolonlar header'da olmalı
+func readCSV(r io.Reader, columns []string, required ...string) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("csv parsing failed: %w", err)
+	}
+
+	known := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		known[column] = true
+	}
+	present := make(map[string]bool, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		if !known[column] {
+			return nil, fmt.Errorf("unknown csv column: %s (allowed: %s)", column, strings.Join(columns, ", "))
+		}
+		header[i] = column
+		present[column] = true
+	}
+	for _, column := range required {
+		if !present[column] {
+			return nil, fmt.Errorf("missing csv column: %s", column)
+		}
+	}
+
+	var rows []map[string]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csv parsing failed: %w", err)
+		}
+
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			row[column] = strings.TrimSpace(record[i])
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseBool - Boş hücre false; satır numarası header dahil 1'den başlar
+func parseBool(row map[string]string, column string, index int) (bool, error) {
+	value := row[column]
+	if value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("row %d: invalid %s value: %s", index+2, column, value)
+	}
+	return parsed, nil
+}
+
+func formatTools(tools []models.ProjectTool) string {
+	parts := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		parts = append(parts, tool.Skill+toolIconSeparator+tool.Icon)
+	}
+	return strings.Join(parts, toolSeparator)
+}
+
+func parseTools(value string) []models.ProjectTool {
+	tools := []models.ProjectTool{}
+	for _, part := range strings.Split(value, toolSeparator) {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		skill, icon, _ := strings.Cut(part, toolIconSeparator)
+		tools = append(tools, models.ProjectTool{Skill: strings.TrimSpace(skill), Icon: strings.TrimSpace(icon)})
+	}
+	return tools
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+
+	"portfolio-backend/bulk"
+	"portfolio-backend/config"
+	"portfolio-backend/handlers"
+)
+
+// Proje/skill bulk import-export CLI (YAML, JSON, CSV)
+// go run ./bulktool -entity projects -export projects.csv
+// go run ./bulktool -entity skills -import skills.yaml [-dry-run]
+// -export - ile stdout'a yazılır, format dosya uzantısından anlaşılır (veya -format)
+func main() {
+	entity := flag.String("entity", "", "projects or skills")
+	exportFile := flag.String("export", "", "Write all items to this file (- for stdout)")
+	importFile := flag.String("import", "", "Upsert items from this file (keyed on id)")
+	format := flag.String("format", "", "yaml, json or csv (default: from file extension)")
+	dryRun := flag.Bool("dry-run", false, "With -import: validate and show the plan without writing")
+	flag.Parse()
+
+	if (*entity != "projects" && *entity != "skills") || (*exportFile == "") == (*importFile == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg := config.LoadConfig()
+	err := config.InitRedis(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize Redis:", err)
+	}
+	defer config.CloseRedis()
+
+	handler := handlers.NewBulkHandler(config.GetRedisClient())
+
+	if *exportFile != "" {
+		runExport(handler, *entity, *exportFile, *format)
+		return
+	}
+	runImport(handler, *entity, *importFile, *format, *dryRun)
+}
+
+func runExport(handler *handlers.BulkHandler, entity, file, format string) {
+	if file == "-" && format == "" {
+		format = bulk.FormatYAML
+	}
+	format, err := bulk.DetectFormat(format, file, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var w io.Writer = os.Stdout
+	if file != "-" {
+		f, err := os.Create(file)
+		if err != nil {
+			log.Fatal("Failed to create export file:", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	export := handler.ExportProjects
+	if entity == "skills" {
+		export = handler.ExportSkills
+	}
+	if err := export(w, format); err != nil {
+		log.Fatal("Export failed:", err)
+	}
+
+	if file != "-" {
+		fmt.Printf("✅ %s exported to %s (%s)\n", entity, file, format)
+	}
+}
+
+func runImport(handler *handlers.BulkHandler, entity, file, format string, dryRun bool) {
+	format, err := bulk.DetectFormat(format, file, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		log.Fatal("Failed to open import file:", err)
+	}
+	defer f.Close()
+
+	fmt.Printf("🚀 %s Import Started (%s)\n", entity, format)
+	fmt.Println("====================================================")
+
+	importer := handler.ImportProjects
+	if entity == "skills" {
+		importer = handler.ImportSkills
+	}
+	report, err := importer(f, format, dryRun)
+	if err != nil {
+		log.Fatal("Import failed:", err)
+	}
+
+	if report.DryRun {
+		fmt.Println("🔍 Dry run - nothing was written")
+	}
+	for _, item := range report.Items {
+		line := fmt.Sprintf("  row %d [%s] %s", item.Row, item.Action, item.ID)
+		if len(item.Fields) > 0 {
+			line += fmt.Sprintf(" %v", item.Fields)
+		}
+		if item.Error != "" {
+			line += " ❌ " + item.Error
+		}
+		fmt.Println(line)
+	}
+
+	fmt.Println("")
+	fmt.Println("📊 Summary:")
+	for _, action := range sortedKeys(report.Summary) {
+		fmt.Printf("  %s: %d\n", action, report.Summary[action])
+	}
+
+	if !report.Valid {
+		fmt.Println("")
+		fmt.Println("❌ Validation failed - nothing was written")
+		os.Exit(1)
+	}
+
+	fmt.Println("")
+	fmt.Printf("🎉 %s Import Completed!\n", entity)
+	fmt.Println("====================================================")
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -36,6 +37,61 @@ type Config struct {
 		MaxLoginAttempts int
 		LoginCooldown    string
 	}
+	Reactions struct {
+		Types        []string
+		Salt         string
+		DedupeWindow string
+	}
+	Mail struct {
+		Driver   string
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
+		LogDir   string
+	}
+	Newsletter struct {
+		TokenSecret string
+		PublicURL   string
+		SiteURL     string
+		ConfirmTTL  string
+	}
+	Contact struct {
+		NotifyEmail string
+		MinFillTime string
+		RateLimit   int
+		RateWindow  string
+	}
+	Webhooks struct {
+		MaxAttempts int
+		RetryBase   string
+		Timeout     string
+	}
+	Revalidate struct {
+		URL         string
+		Secret      string
+		MaxAttempts int
+		RetryBase   string
+		Timeout     string
+	}
+	Frontmatter struct {
+		DialectsFile string
+	}
+	WordPress struct {
+		UploadsDir string
+	}
+	Sync struct {
+		Dir      string
+		Mode     string
+		Interval string
+	}
+	Backup struct {
+		GitDir      string
+		Interval    string
+		AuthorName  string
+		AuthorEmail string
+	}
 }
 
 func LoadConfig() *Config {
@@ -74,6 +130,61 @@ func LoadConfig() *Config {
 	config.Auth.MaxLoginAttempts = getEnvAsInt("MAX_LOGIN_ATTEMPTS", 5)
 	config.Auth.LoginCooldown = getEnv("LOGIN_COOLDOWN", "15m")
 
+	// Reactions configuration
+	config.Reactions.Types = getEnvAsList("REACTION_TYPES", []string{"like", "love", "insightful", "celebrate"})
+	config.Reactions.Salt = getEnv("REACTION_SALT", "default-reaction-salt-change-in-production")
+	config.Reactions.DedupeWindow = getEnv("REACTION_DEDUPE_WINDOW", "24h")
+
+	// Mail configuration (MailHog için: MAIL_DRIVER=smtp MAIL_PORT=1025)
+	config.Mail.Driver = getEnv("MAIL_DRIVER", "log")
+	config.Mail.Host = getEnv("MAIL_HOST", "localhost")
+	config.Mail.Port = getEnv("MAIL_PORT", "1025")
+	config.Mail.Username = getEnv("MAIL_USERNAME", "")
+	config.Mail.Password = getEnv("MAIL_PASSWORD", "")
+	config.Mail.From = getEnv("MAIL_FROM", "Portfolio <no-reply@localhost>")
+	config.Mail.LogDir = getEnv("MAIL_LOG_DIR", "")
+
+	// Newsletter configuration
+	config.Newsletter.TokenSecret = getEnv("NEWSLETTER_TOKEN_SECRET", "default-newsletter-secret-change-in-production")
+	config.Newsletter.PublicURL = getEnv("NEWSLETTER_PUBLIC_URL", "http://localhost:8080")
+	config.Newsletter.SiteURL = getEnv("SITE_URL", "http://localhost:3000")
+	config.Newsletter.ConfirmTTL = getEnv("NEWSLETTER_CONFIRM_TTL", "48h")
+
+	// Contact form configuration (NOTIFY_EMAIL boşsa mail gönderilmez)
+	config.Contact.NotifyEmail = getEnv("CONTACT_NOTIFY_EMAIL", "")
+	config.Contact.MinFillTime = getEnv("CONTACT_MIN_FILL_TIME", "3s")
+	config.Contact.RateLimit = getEnvAsInt("CONTACT_RATE_LIMIT", 5)
+	config.Contact.RateWindow = getEnv("CONTACT_RATE_WINDOW", "1h")
+
+	// Outbound webhook configuration (retry: base, 2*base, 4*base...)
+	config.Webhooks.MaxAttempts = getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 5)
+	config.Webhooks.RetryBase = getEnv("WEBHOOK_RETRY_BASE", "2s")
+	config.Webhooks.Timeout = getEnv("WEBHOOK_TIMEOUT", "10s")
+
+	// Next.js on-demand revalidation (URL boşsa devre dışı)
+	config.Revalidate.URL = getEnv("REVALIDATE_URL", "")
+	config.Revalidate.Secret = getEnv("REVALIDATE_SECRET", "")
+	config.Revalidate.MaxAttempts = getEnvAsInt("REVALIDATE_MAX_ATTEMPTS", 3)
+	config.Revalidate.RetryBase = getEnv("REVALIDATE_RETRY_BASE", "1s")
+	config.Revalidate.Timeout = getEnv("REVALIDATE_TIMEOUT", "5s")
+
+	// MD import frontmatter dialect mapping override'ları (YAML, boşsa built-in'ler)
+	config.Frontmatter.DialectsFile = getEnv("FRONTMATTER_DIALECTS_FILE", "")
+
+	// WordPress import: attachment'ların alınacağı yerel wp-content/uploads kopyası
+	config.WordPress.UploadsDir = getEnv("WORDPRESS_UPLOADS_DIR", "")
+
+	// Content dizini sync (markdown/YAML veya git working tree, DIR boşsa devre dışı)
+	config.Sync.Dir = getEnv("CONTENT_SYNC_DIR", "")
+	config.Sync.Mode = getEnv("CONTENT_SYNC_MODE", "fsnotify") // fsnotify | poll
+	config.Sync.Interval = getEnv("CONTENT_SYNC_INTERVAL", "30s")
+
+	// İçeriğin yerel git repository'sine yedeklenmesi (GIT_DIR boşsa devre dışı, push yapılmaz)
+	config.Backup.GitDir = getEnv("BACKUP_GIT_DIR", "")
+	config.Backup.Interval = getEnv("BACKUP_INTERVAL", "6h") // "0" = sadece manuel
+	config.Backup.AuthorName = getEnv("BACKUP_AUTHOR_NAME", "Portfolio Backup")
+	config.Backup.AuthorEmail = getEnv("BACKUP_AUTHOR_EMAIL", "backup@localhost")
+
 	return config
 }
 
@@ -84,6 +195,21 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsList(key string, defaultValue []string) []string {
+	if value := os.Getenv(key); value != "" {
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		if len(items) > 0 {
+			return items
+		}
+	}
+	return defaultValue
+}
+
 func getEnvAsInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if intValue, err := strconv.Atoi(value); err == nil {
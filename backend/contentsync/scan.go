@@ -0,0 +1,107 @@
+package contentsync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"portfolio-backend/archive"
+)
+
+// Dosya tipleri (export archive düzeni ile aynı)
+const (
+	TypePost    = "post"
+	TypeProject = "project"
+	TypeSkill   = "skill"
+)
+
+// File - Content dizinindeki sync edilecek dosya
+type File struct {
+	Path string // Dizine göre relative, "/" ayraçlı
+	Type string
+	Data []byte
+	Hash string // sha256 (hex)
+}
+
+// Scan - Dizindeki post/proje/skill dosyalarını oku
+// posts: herhangi bir yerdeki *.md / *.mdx (README hariç), projects/*.yaml, skills/*.yaml
+// .git ve gizli dosya/klasörler atlanır, diğer dosyalar yok sayılır
+func Scan(dir string) ([]File, error) {
+	var files []File
+
+	err := filepath.WalkDir(dir, func(diskPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, diskPath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel != "." && isHidden(d.Name()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		fileType := Classify(rel)
+		if fileType == "" {
+			return nil
+		}
+
+		data, err := os.ReadFile(diskPath)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+
+		sum := sha256.Sum256(data)
+		files = append(files, File{
+			Path: rel,
+			Type: fileType,
+			Data: data,
+			Hash: hex.EncodeToString(sum[:]),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan content dir: %w", err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// Classify - Relative path'ten dosya tipi (desteklenmiyorsa boş)
+func Classify(rel string) string {
+	dir := strings.SplitN(rel, "/", 2)[0]
+	ext := strings.ToLower(path.Ext(rel))
+	base := strings.ToLower(path.Base(rel))
+
+	switch {
+	case (ext == ".yaml" || ext == ".yml") && dir == archive.ProjectsDir:
+		return TypeProject
+	case (ext == ".yaml" || ext == ".yml") && dir == archive.SkillsDir:
+		return TypeSkill
+	case (ext == ".md" || ext == ".mdx") && !strings.HasPrefix(base, "readme."):
+		return TypePost
+	}
+	return ""
+}
+
+func isHidden(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
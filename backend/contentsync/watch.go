@@ -0,0 +1,187 @@
+package contentsync
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch modları
+const (
+	ModeFSNotify = "fsnotify"
+	ModePoll     = "poll"
+)
+
+// debounce - git pull / checkout gibi toplu değişiklikler tek sync'e insin
+const debounce = 500 * time.Millisecond
+
+// IsValidMode - Desteklenen watch modu mu?
+func IsValidMode(mode string) bool {
+	return mode == ModeFSNotify || mode == ModePoll
+}
+
+// Watch - ctx iptal edilene kadar dizini izler, değişiklik olunca onChange çağırır
+// fsnotify başlatılamazsa (inotify limiti, network FS...) polling'e düşer
+func Watch(ctx context.Context, dir, mode string, interval time.Duration, onChange func()) error {
+	if !IsValidMode(mode) {
+		return fmt.Errorf("invalid sync mode: %s", mode)
+	}
+
+	if mode == ModeFSNotify {
+		err := watchEvents(ctx, dir, onChange)
+		if err == nil || ctx.Err() != nil {
+			return nil
+		}
+		log.Printf("Content sync: fsnotify failed (%v), falling back to polling every %s", err, interval)
+	}
+
+	return poll(ctx, dir, interval, onChange)
+}
+
+// watchEvents - fsnotify ile tüm alt klasörleri izle (yeni klasörler de eklenir)
+func watchEvents(ctx context.Context, dir string, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := addRecursive(watcher, dir); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return fmt.Errorf("watcher closed")
+			}
+			if hasHiddenPart(dir, event.Name) {
+				continue
+			}
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := addRecursive(watcher, event.Name); err != nil {
+						log.Printf("Content sync: failed to watch %s: %v", event.Name, err)
+					}
+				}
+			}
+			timer.Reset(debounce)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return fmt.Errorf("watcher closed")
+			}
+			log.Printf("Content sync: watcher error: %v", err)
+
+		case <-timer.C:
+			onChange()
+		}
+	}
+}
+
+func addRecursive(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(diskPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if diskPath != root && isHidden(d.Name()) {
+			return filepath.SkipDir
+		}
+		return watcher.Add(diskPath)
+	})
+}
+
+// poll - interval'de bir dosya listesi/boyut/mtime parmak izini karşılaştır
+func poll(ctx context.Context, dir string, interval time.Duration, onChange func()) error {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	last, err := fingerprint(dir)
+	if err != nil {
+		log.Printf("Content sync: %v", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			current, err := fingerprint(dir)
+			if err != nil {
+				log.Printf("Content sync: %v", err)
+				continue
+			}
+			if current != last {
+				last = current
+				onChange()
+			}
+		}
+	}
+}
+
+func fingerprint(dir string) (string, error) {
+	var b strings.Builder
+
+	err := filepath.WalkDir(dir, func(diskPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if diskPath != dir && isHidden(d.Name()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "%s|%d|%d\n", diskPath, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to scan content dir: %w", err)
+	}
+
+	return b.String(), nil
+}
+
+// hasHiddenPart - .git/ altındaki değişiklikler (fetch, gc...) sync tetiklemesin
+func hasHiddenPart(root, name string) bool {
+	rel, err := filepath.Rel(root, name)
+	if err != nil {
+		return false
+	}
+	for _, part := range strings.Split(filepath.ToSlash(rel), "/") {
+		if part != "." && isHidden(part) {
+			return true
+		}
+	}
+	return false
+}
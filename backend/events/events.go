@@ -0,0 +1,114 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Content event isimleri
+// Tek entity'lik CRUD'da handler başarılı bir değişiklikten sonra Publish çağırır
+// (draft'ın yayınlanması gibi PostPublished/PostUpdated ayrımını sadece handler bilir)
+// Handler'dan geçmeyen toplu yazımlar (bulk create, tool/slug/status migration'ları,
+// RebuildIndexes) event'lerini repository'de, V1 migrator kendi yazımlarında yayınlar
+const (
+	PostCreated     = "post.created"
+	PostUpdated     = "post.updated"
+	PostPublished   = "post.published"
+	PostUnpublished = "post.unpublished"
+	PostDeleted     = "post.deleted"
+
+	ProjectCreated = "project.created"
+	ProjectUpdated = "project.updated"
+	ProjectDeleted = "project.deleted"
+
+	ProjectsReordered      = "projects.reordered"
+	ProjectStatusesUpdated = "project_statuses.updated"
+
+	SkillCreated = "skill.created"
+	SkillUpdated = "skill.updated"
+	SkillDeleted = "skill.deleted"
+
+	SkillsReordered = "skills.reordered"
+
+	TimelineCreated = "timeline.created"
+	TimelineUpdated = "timeline.updated"
+	TimelineDeleted = "timeline.deleted"
+
+	TestimonialSubmitted = "testimonial.submitted"
+	TestimonialUpdated   = "testimonial.updated"
+	TestimonialDeleted   = "testimonial.deleted"
+)
+
+// Names - Desteklenen tüm event'ler (webhook validation ve admin UI için)
+var Names = []string{
+	PostCreated, PostUpdated, PostPublished, PostUnpublished, PostDeleted,
+	ProjectCreated, ProjectUpdated, ProjectDeleted, ProjectsReordered, ProjectStatusesUpdated,
+	SkillCreated, SkillUpdated, SkillDeleted, SkillsReordered,
+	TimelineCreated, TimelineUpdated, TimelineDeleted,
+	TestimonialSubmitted, TestimonialUpdated, TestimonialDeleted,
+}
+
+// Event - Yayınlanan content değişikliği
+// Data: ilgili entity (*models.BlogPost, *models.Project, *models.Skill, *models.TimelineEntry, *models.Testimonial)
+// ProjectsReordered'da yeni sıradaki proje ID'leri ([]string)
+// ProjectStatusesUpdated'da güncel status listesi ([]models.ProjectStatus)
+// SkillsReordered'da yeni sıradaki ID'ler ([]string, kategori içi skill ID'leri veya kategoriler)
+// Silme event'lerinde silinmeden önceki hali gönderilir
+type Event struct {
+	Name       string      `json:"event"`
+	Data       interface{} `json:"data"`
+	OccurredAt time.Time   `json:"timestamp"`
+}
+
+// Handler - Event dinleyici fonksiyon
+type Handler func(Event)
+
+// Global subscriber listesi - config.RedisClient gibi uygulama genelinde tek
+var (
+	mu          sync.RWMutex
+	subscribers []Handler
+	pending     sync.WaitGroup
+)
+
+// Subscribe - Event dinleyici ekle (main.go'da uygulama başlarken)
+func Subscribe(handler Handler) {
+	mu.Lock()
+	defer mu.Unlock()
+	subscribers = append(subscribers, handler)
+}
+
+// Publish - Event'i tüm dinleyicilere ilet
+// Her dinleyici kendi goroutine'inde çalışır, HTTP response'u bekletmez
+func Publish(name string, data interface{}) {
+	event := Event{
+		Name:       name,
+		Data:       data,
+		OccurredAt: time.Now(),
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, handler := range subscribers {
+		pending.Add(1)
+		go func(handler Handler) {
+			defer pending.Done()
+			handler(event)
+		}(handler)
+	}
+}
+
+// Wait - Çalışan tüm dinleyicilerin bitmesini bekle
+// HTTP sunucusu beklemez; CLI'lar (V1 migrator) çıkmadan önce çağırır
+func Wait() {
+	pending.Wait()
+}
+
+// IsKnown - Event ismi destekleniyor mu?
+func IsKnown(name string) bool {
+	for _, n := range Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,468 @@
+package frontmatter
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Dialect isimleri
+const (
+	Native = "native" // Kendi export formatımız (camelCase key'ler)
+	Hugo   = "hugo"
+	Jekyll = "jekyll"
+	Astro  = "astro"
+	Auto   = "auto" // Dosya bazında otomatik tespit
+)
+
+// Filename kuralları - frontmatter'da olmayan alanlar dosya adından alınır
+const (
+	FilenameNone     = ""          // Dosya adı kullanılmaz (slug title'dan üretilir)
+	FilenameSlug     = "slug"      // my-post.md -> slug
+	FilenameDateSlug = "date-slug" // 2020-01-02-my-post.md -> publishedAt + slug (Jekyll)
+)
+
+// Targets - Dialect key'lerinin map'lenebileceği native frontmatter key'leri
+// draft native'de yoktur, published'ın tersi olarak uygulanır
+var Targets = []string{
+	"title", "excerpt", "author", "publishedAt", "updatedAt", "tags", "readingTime",
+	"viewCount", "featured", "published", "draft", "slug", "featuredImage",
+	"metaDescription", "metaKeywords",
+}
+
+// Dialect - Static site generator frontmatter'ının native key'lere mapping'i
+// Fields: native key -> kaynak key'ler (ilk dolu olan kullanılır, tags hepsini birleştirir)
+// Map'lenmeyen key'ler olduğu gibi kalır ve Extras'a düşer
+type Dialect struct {
+	Name     string              `yaml:"-" json:"name"`
+	Fields   map[string][]string `yaml:"fields" json:"fields"`
+	Markers  []string            `yaml:"markers" json:"markers"`   // Auto-detect için dialect'e özgü key'ler
+	Filename string              `yaml:"filename" json:"filename"` // FilenameNone, FilenameSlug, FilenameDateSlug
+}
+
+// builtinDialects - Varsayılan mapping'ler (FRONTMATTER_DIALECTS_FILE ile override edilebilir)
+func builtinDialects() map[string]*Dialect {
+	return map[string]*Dialect{
+		Native: {
+			Markers: []string{"publishedAt", "featuredImage", "readingTime", "viewCount", "metaDescription", "metaKeywords"},
+		},
+		Hugo: {
+			Fields: map[string][]string{
+				"title":           {"title", "linkTitle"},
+				"excerpt":         {"summary", "description"},
+				"author":          {"author", "authors"},
+				"publishedAt":     {"date", "publishDate"},
+				"updatedAt":       {"lastmod"},
+				"tags":            {"tags", "categories"},
+				"featured":        {"featured"},
+				"draft":           {"draft"},
+				"slug":            {"slug"},
+				"featuredImage":   {"featured_image", "images", "image", "cover"},
+				"metaDescription": {"description"},
+				"metaKeywords":    {"keywords"},
+			},
+			Markers:  []string{"lastmod", "publishDate", "draft", "images", "linkTitle", "aliases", "weight", "expiryDate"},
+			Filename: FilenameSlug,
+		},
+		Jekyll: {
+			Fields: map[string][]string{
+				"title":           {"title"},
+				"excerpt":         {"excerpt", "description"},
+				"author":          {"author"},
+				"publishedAt":     {"date"},
+				"updatedAt":       {"last_modified_at"},
+				"tags":            {"tags", "categories", "category"},
+				"published":       {"published"},
+				"slug":            {"slug"},
+				"featuredImage":   {"image"},
+				"metaDescription": {"description"},
+			},
+			Markers:  []string{"layout", "categories", "category", "permalink", "last_modified_at"},
+			Filename: FilenameDateSlug,
+		},
+		Astro: {
+			Fields: map[string][]string{
+				"title":           {"title"},
+				"excerpt":         {"description"},
+				"author":          {"author"},
+				"publishedAt":     {"pubDate", "publishDate", "date"},
+				"updatedAt":       {"updatedDate"},
+				"tags":            {"tags"},
+				"draft":           {"draft"},
+				"slug":            {"slug"},
+				"featuredImage":   {"heroImage", "image", "cover"},
+				"metaDescription": {"description"},
+			},
+			Markers:  []string{"pubDate", "updatedDate", "heroImage"},
+			Filename: FilenameSlug,
+		},
+	}
+}
+
+// jekyllFilename - Jekyll post dosya adı: YYYY-MM-DD-slug.md
+var jekyllFilename = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})-(.+)$`)
+
+// Registry - Kullanılabilir dialect'ler
+type Registry struct {
+	dialects map[string]*Dialect
+}
+
+// NewRegistry - Built-in dialect'lerle registry oluştur
+// mappingFile verilmişse (YAML) dialect'ler override edilir veya yeni dialect eklenir
+func NewRegistry(mappingFile string) (*Registry, error) {
+	registry := &Registry{dialects: builtinDialects()}
+	for name, dialect := range registry.dialects {
+		dialect.Name = name
+	}
+
+	if mappingFile == "" {
+		return registry, nil
+	}
+
+	data, err := os.ReadFile(mappingFile)
+	if err != nil {
+		return registry, fmt.Errorf("failed to read dialect mapping file: %w", err)
+	}
+
+	var overrides map[string]*Dialect
+	if err := yaml.Unmarshal(data, &overrides); err != nil {
+		return registry, fmt.Errorf("failed to parse dialect mapping file: %w", err)
+	}
+
+	// Hatalı dosyada built-in'ler değişmeden kalsın diye önce hepsi doğrulanır
+	for name, override := range overrides {
+		if name == Auto || override == nil {
+			return registry, fmt.Errorf("invalid dialect definition: %s", name)
+		}
+		if err := ValidateFields(override.Fields); err != nil {
+			return registry, fmt.Errorf("dialect %s: %w", name, err)
+		}
+	}
+
+	for name, override := range overrides {
+		dialect, ok := registry.dialects[name]
+		if !ok {
+			override.Name = name
+			registry.dialects[name] = override
+			continue
+		}
+
+		dialect = dialect.WithFields(override.Fields)
+		if override.Markers != nil {
+			dialect.Markers = override.Markers
+		}
+		if override.Filename != "" {
+			dialect.Filename = override.Filename
+		}
+		registry.dialects[name] = dialect
+	}
+
+	return registry, nil
+}
+
+// Get - İsme göre dialect
+func (r *Registry) Get(name string) (*Dialect, error) {
+	dialect, ok := r.dialects[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown frontmatter dialect: %s", name)
+	}
+	return dialect, nil
+}
+
+// All - Tüm dialect'ler (isme göre sıralı)
+func (r *Registry) All() []*Dialect {
+	names := make([]string, 0, len(r.dialects))
+	for name := range r.dialects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dialects := make([]*Dialect, 0, len(names))
+	for _, name := range names {
+		dialects = append(dialects, r.dialects[name])
+	}
+	return dialects
+}
+
+// Detect - Dosyanın dialect'ini tahmin et
+// Native key'ler varsa native; yoksa marker key sayısı, delimiter ve dosya adı puanlanır
+func (r *Registry) Detect(format string, fields map[string]interface{}, filename string) *Dialect {
+	if native, ok := r.dialects[Native]; ok && countMarkers(native, fields) > 0 {
+		return native
+	}
+
+	scores := make(map[string]int)
+	for name, dialect := range r.dialects {
+		if name != Native {
+			scores[name] = countMarkers(dialect, fields) * 2
+		}
+	}
+
+	// Delimiter ve uzantı ipuçları
+	if format == FormatTOML || format == FormatJSON {
+		scores[Hugo]++
+	}
+	base := path.Base(filename)
+	if strings.HasSuffix(base, ".mdx") {
+		scores[Astro]++
+	}
+	if jekyllFilename.MatchString(strings.TrimSuffix(base, path.Ext(base))) {
+		scores[Jekyll]++
+	}
+
+	best, bestScore := Native, 0
+	for _, name := range []string{Hugo, Jekyll, Astro} {
+		if scores[name] > bestScore {
+			best, bestScore = name, scores[name]
+		}
+	}
+	// Config'den eklenen dialect'ler built-in'lerden sonra değerlendirilir
+	for _, dialect := range r.All() {
+		if scores[dialect.Name] > bestScore {
+			best, bestScore = dialect.Name, scores[dialect.Name]
+		}
+	}
+
+	// Hiç ipucu yoksa ama "date" varsa generic SSG frontmatter'ı
+	if bestScore == 0 {
+		if _, ok := fields["date"]; ok {
+			best = Hugo
+		}
+	}
+
+	if dialect, ok := r.dialects[best]; ok {
+		return dialect
+	}
+	return r.dialects[Native]
+}
+
+func countMarkers(dialect *Dialect, fields map[string]interface{}) int {
+	count := 0
+	for _, marker := range dialect.Markers {
+		if _, ok := fields[marker]; ok {
+			count++
+		}
+	}
+	return count
+}
+
+// ValidateFields - Mapping hedeflerinin native key olduğunu kontrol et
+func ValidateFields(fields map[string][]string) error {
+	for target := range fields {
+		if !isTarget(target) {
+			return fmt.Errorf("unknown target field: %s (valid: %s)", target, strings.Join(Targets, ", "))
+		}
+	}
+	return nil
+}
+
+func isTarget(key string) bool {
+	for _, target := range Targets {
+		if target == key {
+			return true
+		}
+	}
+	return false
+}
+
+// WithFields - Mapping'i verilen alanlarla override edilmiş kopya
+func (d *Dialect) WithFields(fields map[string][]string) *Dialect {
+	merged := make(map[string][]string, len(d.Fields)+len(fields))
+	for target, sources := range d.Fields {
+		merged[target] = sources
+	}
+	for target, sources := range fields {
+		merged[target] = sources
+	}
+
+	copied := *d
+	copied.Fields = merged
+	return &copied
+}
+
+// Map - Dialect key'lerini native frontmatter key'lerine çevir
+// Sonuç native YAML olarak marshal edilip MDFrontmatter'a parse edilebilir
+func (d *Dialect) Map(fields map[string]interface{}, filename string) map[string]interface{} {
+	mapped := make(map[string]interface{})
+	used := make(map[string]bool)
+
+	for target, sources := range d.Fields {
+		if target == "tags" {
+			var tags []string
+			for _, source := range sources {
+				if value, ok := fields[source]; ok && value != nil {
+					if single, ok := value.(string); ok && source == "category" {
+						tags = append(tags, strings.TrimSpace(single)) // Jekyll: tek kategori, boşluk içerebilir
+					} else {
+						tags = append(tags, toStrings(value)...)
+					}
+					used[source] = true
+				}
+			}
+			if len(sources) > 0 && tags != nil {
+				mapped["tags"] = dedupe(tags)
+			}
+			continue
+		}
+
+		for _, source := range sources {
+			value, ok := fields[source]
+			if !ok || value == nil {
+				continue
+			}
+			used[source] = true
+			mapped[target] = convert(target, value)
+			break
+		}
+	}
+
+	// Map'lenmeyen key'ler native parse'ta Extras'a düşer
+	for key, value := range fields {
+		if used[key] {
+			continue
+		}
+		if _, exists := mapped[key]; exists {
+			continue
+		}
+		mapped[key] = value
+	}
+
+	// draft -> published (published açıkça verilmişse o kazanır)
+	if draft, ok := mapped["draft"]; ok {
+		delete(mapped, "draft")
+		if isDraft, ok := draft.(bool); ok {
+			if _, exists := mapped["published"]; !exists {
+				mapped["published"] = !isDraft
+			}
+		}
+	}
+
+	d.applyFilename(mapped, filename)
+	return mapped
+}
+
+// applyFilename - Frontmatter'da olmayan slug/tarih'i dosya adından al
+func (d *Dialect) applyFilename(mapped map[string]interface{}, filename string) {
+	if d.Filename == FilenameNone || filename == "" {
+		return
+	}
+
+	base := path.Base(strings.ReplaceAll(filename, "\\", "/"))
+	name := strings.TrimSuffix(base, path.Ext(base))
+	if name == "index" || name == "_index" {
+		return // Hugo page bundle, dosya adı slug değil
+	}
+
+	if d.Filename == FilenameDateSlug {
+		if match := jekyllFilename.FindStringSubmatch(name); match != nil {
+			if _, exists := mapped["publishedAt"]; !exists {
+				mapped["publishedAt"] = match[1]
+			}
+			name = match[2]
+		}
+	}
+
+	if _, exists := mapped["slug"]; !exists && name != "" {
+		mapped["slug"] = name
+	}
+}
+
+// convert - Kaynak değeri native key'in beklediği tipe çevir
+func convert(target string, value interface{}) interface{} {
+	switch target {
+	case "publishedAt", "updatedAt":
+		switch v := value.(type) {
+		case time.Time:
+			return FormatTime(v)
+		case string:
+			if parsed, err := ParseTime(v); err == nil {
+				return FormatTime(parsed)
+			}
+			return v // Native parse anlamlı bir hata döner
+		}
+		return fmt.Sprint(value)
+	case "featuredImage":
+		return firstString(value)
+	case "author", "metaKeywords":
+		if list, ok := value.([]interface{}); ok {
+			return strings.Join(toStrings(list), ", ")
+		}
+		return fmt.Sprint(value)
+	case "title", "excerpt", "slug", "metaDescription", "readingTime":
+		if s, ok := value.(string); ok {
+			return s
+		}
+		return fmt.Sprint(value)
+	}
+	return value
+}
+
+// firstString - Liste ise ilk eleman, map ise image/src/url (Hugo cover: {image: ...})
+func firstString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		for _, item := range v {
+			if s := firstString(item); s != "" {
+				return s
+			}
+		}
+	case map[string]interface{}:
+		for _, key := range []string{"image", "src", "url"} {
+			if item, ok := v[key]; ok {
+				return firstString(item)
+			}
+		}
+	}
+	return ""
+}
+
+// toStrings - Liste veya string'i string listesine çevir
+// Tek string ise Jekyll'deki gibi boşlukla ("categories: web go"), virgül varsa virgülle ayrılır
+func toStrings(value interface{}) []string {
+	var values []string
+	switch v := value.(type) {
+	case string:
+		separator := func(r rune) bool { return r == ' ' || r == '\t' }
+		if strings.Contains(v, ",") {
+			separator = func(r rune) bool { return r == ',' }
+		}
+		for _, part := range strings.FieldsFunc(v, separator) {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if item == nil {
+				continue
+			}
+			if value := strings.TrimSpace(fmt.Sprint(item)); value != "" {
+				values = append(values, value)
+			}
+		}
+	case nil:
+	default:
+		values = append(values, fmt.Sprint(v))
+	}
+	return values
+}
+
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,173 @@
+package frontmatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Frontmatter formatları (delimiter'a göre)
+const (
+	FormatYAML = "yaml" // --- ... ---
+	FormatTOML = "toml" // +++ ... +++
+	FormatJSON = "json" // { ... } (Hugo)
+)
+
+// Document - Frontmatter'ı ayrılmış MD dosyası
+type Document struct {
+	Format string
+	Raw    string // Delimiter'lar hariç frontmatter
+	Body   string
+}
+
+// Split - MD içeriğinden frontmatter'ı ayır, formatı delimiter'dan belirle
+// Kapanış delimiter'ından sonraki tek boş satır ve dosya sonundaki tek newline ayraç sayılır,
+// body'nin geri kalanı olduğu gibi korunur (export -> import -> export birebir aynı kalsın)
+func Split(content string) (*Document, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.TrimLeft(content, "\ufeff \t\n")
+
+	switch {
+	case strings.HasPrefix(content, "---"):
+		return splitDelimited(content, "---", FormatYAML)
+	case strings.HasPrefix(content, "+++"):
+		return splitDelimited(content, "+++", FormatTOML)
+	case strings.HasPrefix(content, "{"):
+		return splitJSON(content)
+	}
+
+	return nil, fmt.Errorf("frontmatter must start with ---, +++ or {")
+}
+
+func splitDelimited(content, delimiter, format string) (*Document, error) {
+	lines := strings.Split(content, "\n")
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == delimiter {
+			end = i
+			break
+		}
+	}
+
+	if end == -1 {
+		return nil, fmt.Errorf("frontmatter must end with %s", delimiter)
+	}
+
+	return &Document{
+		Format: format,
+		Raw:    strings.Join(lines[1:end], "\n"),
+		Body:   trimBody(strings.Join(lines[end+1:], "\n")),
+	}, nil
+}
+
+// splitJSON - JSON frontmatter'ın sonu decoder offset'i ile bulunur (body'de { geçebilir)
+func splitJSON(content string) (*Document, error) {
+	decoder := json.NewDecoder(strings.NewReader(content))
+	var raw json.RawMessage
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON frontmatter: %w", err)
+	}
+
+	rest := content[decoder.InputOffset():]
+	rest = strings.TrimLeft(rest, " \t")
+	rest = strings.TrimPrefix(rest, "\n")
+
+	return &Document{
+		Format: FormatJSON,
+		Raw:    string(raw),
+		Body:   trimBody(rest),
+	}, nil
+}
+
+func trimBody(body string) string {
+	body = strings.TrimPrefix(body, "\n")
+	return strings.TrimSuffix(body, "\n")
+}
+
+// Decode - Frontmatter'ı formatından bağımsız map'e çevir
+// TOML local tarih tipleri time.Time'a çevrilir (YAML ile aynı davranış)
+func (d *Document) Decode() (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+
+	var err error
+	switch d.Format {
+	case FormatYAML:
+		err = yaml.Unmarshal([]byte(d.Raw), &fields)
+	case FormatTOML:
+		err = toml.NewDecoder(bytes.NewReader([]byte(d.Raw))).Decode(&fields)
+	case FormatJSON:
+		err = json.Unmarshal([]byte(d.Raw), &fields)
+	default:
+		err = fmt.Errorf("unknown format: %s", d.Format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s frontmatter parsing failed: %w", d.Format, err)
+	}
+
+	for key, value := range fields {
+		fields[key] = normalizeValue(value)
+	}
+	return fields, nil
+}
+
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case toml.LocalDate:
+		return v.AsTime(time.UTC)
+	case toml.LocalDateTime:
+		return v.AsTime(time.UTC)
+	case toml.LocalTime:
+		return v.String()
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeValue(item)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeValue(v[i])
+		}
+	}
+	return value
+}
+
+// timeLayouts - Static site generator'larda görülen tarih formatları
+var timeLayouts = []string{
+	"2006-01-02",
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05 -0700", // Jekyll
+	"2006-01-02 15:04:05 -07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"Jan 02 2006", // Astro blog template
+	"Jan 2 2006",
+	"January 2, 2006",
+}
+
+// ParseTime - Frontmatter tarihini parse et ("2006-01-02", RFC3339 ve SSG formatları)
+func ParseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported date format: %q", value)
+}
+
+// FormatTime - Gece yarısı (UTC) ise sadece tarih, değilse RFC3339 (nanosecond dahil)
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	if t.Equal(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)) && t.Location() == time.UTC {
+		return t.Format("2006-01-02")
+	}
+	return t.Format(time.RFC3339Nano)
+}
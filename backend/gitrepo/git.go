@@ -0,0 +1,162 @@
+package gitrepo
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Change durumları (git diff --name-status)
+const (
+	Added    = "A"
+	Modified = "M"
+	Deleted  = "D"
+)
+
+// Repo - Yerel git repository (git binary'si üzerinden, remote/push yok)
+type Repo struct {
+	Dir         string
+	AuthorName  string
+	AuthorEmail string
+}
+
+// Change - Stage'lenmiş tek dosya değişikliği
+type Change struct {
+	Status string `json:"status"`
+	Path   string `json:"path"`
+}
+
+// Commit - git log kaydı
+type Commit struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Date    time.Time `json:"date"`
+	Subject string    `json:"subject"`
+}
+
+// Open - Dizini aç, repository değilse git init ile oluştur
+func Open(dir, authorName, authorEmail string) (*Repo, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create repository dir: %w", err)
+	}
+
+	repo := &Repo{Dir: dir, AuthorName: authorName, AuthorEmail: authorEmail}
+	if _, err := os.Stat(filepath.Join(dir, ".git")); os.IsNotExist(err) {
+		if _, err := repo.run("init", "--quiet"); err != nil {
+			return nil, err
+		}
+	}
+
+	return repo, nil
+}
+
+// StageAll - Çalışma dizinindeki tüm değişiklikleri stage'le ve listele
+func (r *Repo) StageAll() ([]Change, error) {
+	if _, err := r.run("add", "--all"); err != nil {
+		return nil, err
+	}
+
+	out, err := r.run("diff", "--cached", "--name-status", "--no-renames", "-z")
+	if err != nil {
+		return nil, err
+	}
+
+	// -z çıktısı: status\0path\0status\0path\0...
+	var changes []Change
+	parts := strings.Split(strings.TrimSuffix(out, "\x00"), "\x00")
+	for i := 0; i+1 < len(parts); i += 2 {
+		changes = append(changes, Change{Status: parts[i], Path: parts[i+1]})
+	}
+	return changes, nil
+}
+
+// Commit - Stage'lenmiş değişiklikleri commit et, hash'i döndür
+func (r *Repo) Commit(message string) (string, error) {
+	if _, err := r.run("commit", "--quiet", "--no-verify", "-m", message); err != nil {
+		return "", err
+	}
+	return r.Resolve("HEAD")
+}
+
+// Resolve - Revision'ı (hash, kısa hash, HEAD~2, tag...) tam commit hash'ine çevir
+func (r *Repo) Resolve(rev string) (string, error) {
+	out, err := r.run("rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("commit not found: %s", rev)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// Log - Son commit'ler (en yeni önce)
+func (r *Repo) Log(limit int) ([]Commit, error) {
+	if _, err := r.Resolve("HEAD"); err != nil {
+		return []Commit{}, nil // Henüz commit yok
+	}
+
+	out, err := r.run("log", fmt.Sprintf("--max-count=%d", limit), "--format=%H%x1f%an%x1f%aI%x1f%s%x1e")
+	if err != nil {
+		return nil, err
+	}
+
+	commits := []Commit{}
+	for _, record := range strings.Split(out, "\x1e") {
+		fields := strings.Split(strings.TrimSpace(record), "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, fields[2])
+		commits = append(commits, Commit{Hash: fields[0], Author: fields[1], Date: date, Subject: fields[3]})
+	}
+	return commits, nil
+}
+
+// Files - Commit'teki tüm dosya path'leri
+func (r *Repo) Files(rev string) ([]string, error) {
+	out, err := r.run("ls-tree", "-r", "--name-only", "-z", rev)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, name := range strings.Split(out, "\x00") {
+		if name != "" {
+			files = append(files, name)
+		}
+	}
+	return files, nil
+}
+
+// ReadFile - Dosyanın commit'teki içeriği
+func (r *Repo) ReadFile(rev, path string) ([]byte, error) {
+	out, err := r.run("show", rev+":"+path)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(out), nil
+}
+
+// run - git komutunu repository dizininde çalıştır
+// Author/committer env ile verilir (sunucuda global git config gerekmesin)
+func (r *Repo) run(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-c", "commit.gpgsign=false"}, args...)...)
+	cmd.Dir = r.Dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME="+r.AuthorName,
+		"GIT_AUTHOR_EMAIL="+r.AuthorEmail,
+		"GIT_COMMITTER_NAME="+r.AuthorName,
+		"GIT_COMMITTER_EMAIL="+r.AuthorEmail,
+	)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"portfolio-backend/archive"
+	"portfolio-backend/config"
+	"portfolio-backend/models"
+)
+
+// writeUploads - Çalışma klasörüne (t.Chdir) upload dosyaları yaz
+func writeUploads(t *testing.T, files map[string]string) {
+	t.Helper()
+	for name, data := range files {
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// readZip - Archive'ı zip.Reader olarak aç
+func readZip(t *testing.T, data []byte) *zip.Reader {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+	return zr
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	t.Chdir(t.TempDir())
+	mr, client := newTestRedis(t)
+	cfg := &config.Config{}
+
+	uploads := map[string]string{
+		"blog-upload/cover.png":      "cover-bytes",
+		"uploads/gallery/p1/one.png": "gallery-bytes",
+		"skills-upload/go.svg":       "<svg/>",
+	}
+	writeUploads(t, uploads)
+
+	post := models.NewBlogPost("Hello Archive", "Intro\n\n![cover](/blog-upload/cover.png)\n", "Jane", []string{"go"})
+	post.Published = true
+	post.FeaturedImage = "/blog-upload/cover.png"
+	if err := models.NewBlogRepository(client).CreatePost(post); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	project := models.NewProject("Site", "A site", "https://example.com", "/uploads/gallery/p1/one.png", "Live", nil)
+	if err := models.NewProjectsRepository(client).CreateProject(project); err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	skill := models.NewSkill("Backend", "Go", "/skills-upload/go.svg")
+	if err := models.NewSkillsRepository(client).CreateSkill(skill); err != nil {
+		t.Fatalf("CreateSkill: %v", err)
+	}
+
+	// Export
+	exporter := NewExportHandler(cfg, client)
+	posts, _ := exporter.blogRepo.GetAllPosts()
+	projects, _ := exporter.projectsRepo.GetAllProjects()
+	skills, _ := exporter.skillsRepo.GetAllSkills()
+	files, err := archive.ListUploads()
+	if err != nil {
+		t.Fatalf("ListUploads: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := exporter.writeArchive(archive.NewWriter(&buf), posts, projects, skills, files); err != nil {
+		t.Fatalf("writeArchive: %v", err)
+	}
+	exported := buf.Bytes()
+
+	zr := readZip(t, exported)
+	for _, f := range zr.File {
+		if strings.HasPrefix(f.Name, archive.PostsDir+"/") {
+			data, _ := archive.ReadEntry(f)
+			if !strings.Contains(string(data), "](../blog-upload/cover.png)") {
+				t.Errorf("exported post does not use archive paths:\n%s", data)
+			}
+		}
+	}
+
+	// Boş bir siteye import
+	mr.FlushAll()
+	for _, dir := range []string{"blog-upload", "uploads", "skills-upload"} {
+		os.RemoveAll(dir)
+	}
+
+	importer := NewImportHandler(cfg, client)
+	skipAll := ImportModes{Posts: archive.ModeSkip, Projects: archive.ModeSkip, Skills: archive.ModeSkip, Files: archive.ModeSkip}
+	manifest, err := archive.ReadManifest(zr)
+	if err != nil {
+		t.Fatalf("ReadManifest: %v", err)
+	}
+	for _, item := range importer.importArchive(zr, manifest, skipAll) {
+		if item.Action != ImportCreated {
+			t.Errorf("first import: %s %s = %s %s, want created", item.Type, item.Path, item.Action, item.Error)
+		}
+	}
+
+	for name, want := range uploads {
+		if got, err := os.ReadFile(name); err != nil || string(got) != want {
+			t.Errorf("%s = %q, %v; want %q", name, got, err, want)
+		}
+	}
+
+	gotPost, err := importer.blogRepo.GetPostBySlug(post.Slug)
+	if err != nil {
+		t.Fatalf("GetPostBySlug: %v", err)
+	}
+	if !strings.Contains(gotPost.Content, "](/blog-upload/cover.png)") || gotPost.FeaturedImage != post.FeaturedImage ||
+		gotPost.Title != post.Title || !gotPost.Published {
+		t.Errorf("imported post = %+v, want %+v", gotPost, post)
+	}
+	gotProject, err := importer.projectsRepo.GetProjectByID(project.ID)
+	if err != nil || gotProject.Image != project.Image || gotProject.Status != project.Status {
+		t.Errorf("imported project = %+v, %v; want image %s", gotProject, err, project.Image)
+	}
+	gotSkill, err := importer.skillsRepo.GetSkillByID(skill.ID)
+	if err != nil || gotSkill.Icon != skill.Icon {
+		t.Errorf("imported skill = %+v, %v; want icon %s", gotSkill, err, skill.Icon)
+	}
+
+	// Aynı archive tekrar: entity'ler atlanır, dosyalar değişmemiş
+	for _, item := range importer.importArchive(zr, manifest, skipAll) {
+		want := ImportSkipped
+		if item.Type == "file" {
+			want = ImportUnchanged
+		}
+		if item.Action != want {
+			t.Errorf("second import: %s %s = %s %s, want %s", item.Type, item.Path, item.Action, item.Error, want)
+		}
+	}
+}
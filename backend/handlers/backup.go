@@ -0,0 +1,467 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"portfolio-backend/archive"
+	"portfolio-backend/config"
+	"portfolio-backend/contentsync"
+	"portfolio-backend/gitrepo"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// BackupResult - Tek backup çalışmasının sonucu
+type BackupResult struct {
+	Commit  string           `json:"commit,omitempty"` // Değişiklik yoksa boş
+	Message string           `json:"message"`
+	Changes []gitrepo.Change `json:"changes"`
+	Counts  map[string]int   `json:"counts"` // Yazılan dosya sayıları (posts, projects, skills)
+}
+
+// RestoreRequest - Commit'i Redis'e geri yükleme isteği
+type RestoreRequest struct {
+	Commit string `json:"commit" binding:"required"` // Hash, kısa hash, HEAD~1, tag...
+	Prune  bool   `json:"prune"`                     // Commit'te olmayan post/proje/skill'leri sil
+}
+
+// RestoreReport - Geri yükleme sonucu
+type RestoreReport struct {
+	Commit  string                   `json:"commit"`
+	Prune   bool                     `json:"prune"`
+	Summary map[string]int           `json:"summary"`
+	Items   []models.ContentSyncItem `json:"items"`
+}
+
+// BackupHandler - Redis içeriğini yerel git repository'sine dosya olarak yedekler
+// Dosya düzeni export archive ve content sync ile aynı, remote/push yok
+type BackupHandler struct {
+	gitDir      string
+	interval    time.Duration
+	authorName  string
+	authorEmail string
+
+	mu           sync.Mutex // Aynı anda tek backup/restore
+	blog         *BlogHandler
+	sync         *SyncHandler // Restore dosyaları sync ile aynı yoldan yazar
+	blogRepo     *models.BlogRepository
+	projectsRepo *models.ProjectsRepository
+	skillsRepo   *models.SkillsRepository
+}
+
+// NewBackupHandler - Yeni handler oluştur
+func NewBackupHandler(cfg *config.Config, redisClient *redis.Client) *BackupHandler {
+	interval, err := time.ParseDuration(cfg.Backup.Interval)
+	if err != nil {
+		interval = 0
+	}
+
+	return &BackupHandler{
+		gitDir:       cfg.Backup.GitDir,
+		interval:     interval,
+		authorName:   cfg.Backup.AuthorName,
+		authorEmail:  cfg.Backup.AuthorEmail,
+		blog:         NewBlogHandler(cfg, redisClient),
+		sync:         NewSyncHandler(cfg, redisClient),
+		blogRepo:     models.NewBlogRepository(redisClient),
+		projectsRepo: models.NewProjectsRepository(redisClient),
+		skillsRepo:   models.NewSkillsRepository(redisClient),
+	}
+}
+
+// Enabled - BACKUP_GIT_DIR tanımlı mı?
+func (h *BackupHandler) Enabled() bool {
+	return h.gitDir != ""
+}
+
+// Schedule - Başlangıçta ve her BACKUP_INTERVAL'de backup al
+// main'den goroutine olarak çalıştırılır, interval 0 ise sadece manuel
+func (h *BackupHandler) Schedule(ctx context.Context) {
+	if !h.Enabled() || h.interval <= 0 {
+		return
+	}
+
+	h.runLogged()
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.runLogged()
+		}
+	}
+}
+
+// RunBackup - Backup'ı elle çalıştır
+// POST /api/v1/admin/backup
+func (h *BackupHandler) RunBackup(c *gin.Context) {
+	if !h.Enabled() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Git backup is not configured (BACKUP_GIT_DIR)",
+		})
+		return
+	}
+
+	result, err := h.Run()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Backup failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetBackupCommits - Backup geçmişi
+// GET /api/v1/admin/backup/commits?limit=20
+func (h *BackupHandler) GetBackupCommits(c *gin.Context) {
+	if !h.Enabled() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Git backup is not configured (BACKUP_GIT_DIR)",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+
+	commits, err := h.Log(limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to read backup history",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":   len(commits),
+		"commits": commits,
+	})
+}
+
+// RestoreBackup - Commit'teki içeriği Redis'e geri yükle
+// POST /api/v1/admin/backup/restore
+// Body: {"commit": "a1b2c3d", "prune": false}
+func (h *BackupHandler) RestoreBackup(c *gin.Context) {
+	if !h.Enabled() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Git backup is not configured (BACKUP_GIT_DIR)",
+		})
+		return
+	}
+
+	var req RestoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	report, err := h.Restore(req.Commit, req.Prune)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Restore failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// Run - Tüm içeriği repository'ye yaz, değişiklik varsa özet mesajla commit et
+func (h *BackupHandler) Run() (*BackupResult, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repo, err := h.open()
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := h.writeSnapshot(repo.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := repo.StageAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BackupResult{Changes: changes, Counts: counts}
+	if len(changes) == 0 {
+		result.Changes = []gitrepo.Change{}
+		result.Message = "No changes since last backup"
+		return result, nil
+	}
+
+	result.Message = backupMessage(changes)
+	if result.Commit, err = repo.Commit(result.Message); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Log - Son backup commit'leri
+func (h *BackupHandler) Log(limit int) ([]gitrepo.Commit, error) {
+	repo, err := h.open()
+	if err != nil {
+		return nil, err
+	}
+	return repo.Log(limit)
+}
+
+// Restore - Commit'teki dosyaları content sync ile aynı kurallarla Redis'e yaz
+// Sayaçlar (view_count) backup'ta tutulmadığı için Redis'teki değerler korunur
+func (h *BackupHandler) Restore(rev string, prune bool) (*RestoreReport, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	repo, err := h.open()
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := repo.Resolve(rev)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := repo.Files(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RestoreReport{
+		Commit:  commit,
+		Prune:   prune,
+		Summary: make(map[string]int),
+		Items:   []models.ContentSyncItem{},
+	}
+	seen := make(map[string]bool)
+
+	for _, filePath := range paths {
+		fileType := contentsync.Classify(filePath)
+		if fileType == "" {
+			continue
+		}
+
+		data, err := repo.ReadFile(commit, filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		item, synced := h.sync.syncFile(contentsync.File{Path: filePath, Type: fileType, Data: data}, models.SyncedFile{})
+		if synced != nil {
+			seen[synced.Type+"|"+synced.ID] = true
+		}
+		report.Summary[item.Action]++
+		report.Items = append(report.Items, item)
+	}
+
+	if prune {
+		current, err := h.currentEntities()
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range current {
+			if seen[entity.Type+"|"+entity.ID] {
+				continue
+			}
+			item := h.sync.deleteEntity("", entity)
+			report.Summary[item.Action]++
+			report.Items = append(report.Items, item)
+		}
+	}
+
+	return report, nil
+}
+
+// runLogged - Zamanlanmış çalışmalar için (sonuç log'a yazılır)
+func (h *BackupHandler) runLogged() {
+	result, err := h.Run()
+	if err != nil {
+		log.Printf("Git backup failed: %v", err)
+		return
+	}
+	if result.Commit == "" {
+		return
+	}
+	log.Printf("Git backup %s: %s", result.Commit[:7], strings.SplitN(result.Message, "\n", 2)[0])
+}
+
+func (h *BackupHandler) open() (*gitrepo.Repo, error) {
+	return gitrepo.Open(h.gitDir, h.authorName, h.authorEmail)
+}
+
+// writeSnapshot - Yönetilen dosyaları sil ve Redis'teki güncel hali yaz
+// Silinen entity'ler git'te "deleted" olarak görünür
+func (h *BackupHandler) writeSnapshot(dir string) (map[string]int, error) {
+	posts, err := h.blogRepo.GetAllPosts()
+	if err != nil {
+		return nil, err
+	}
+	projects, err := h.projectsRepo.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+	skills, err := h.skillsRepo.GetAllSkills()
+	if err != nil {
+		return nil, err
+	}
+
+	// Görüntülenme sayıları içerik değil, her backup'ta diff üretmesin
+	// (view artışı UpdatedAt'i de değiştirdiği için o da yazılmaz)
+	for i := range posts {
+		posts[i].ViewCount = 0
+		posts[i].UpdatedAt = time.Time{}
+	}
+	for i := range projects {
+		projects[i].ViewCount = 0
+		projects[i].UpdatedAt = time.Time{}
+	}
+
+	files, err := contentFiles(h.blog, posts, projects, skills, func(content string) string {
+		return content
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := removeContentFiles(dir); err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{"posts": 0, "projects": 0, "skills": 0}
+	for _, file := range files {
+		diskPath := filepath.Join(dir, filepath.FromSlash(file.Name))
+		if err := os.MkdirAll(filepath.Dir(diskPath), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", filepath.Dir(diskPath), err)
+		}
+		if err := os.WriteFile(diskPath, file.Data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+		counts[file.Kind]++
+	}
+
+	return counts, nil
+}
+
+// currentEntities - Redis'teki tüm post/proje/skill'ler (prune için)
+func (h *BackupHandler) currentEntities() ([]models.SyncedFile, error) {
+	var entities []models.SyncedFile
+
+	posts, err := h.blogRepo.GetAllPosts()
+	if err != nil {
+		return nil, err
+	}
+	for _, post := range posts {
+		entities = append(entities, models.SyncedFile{Type: contentsync.TypePost, ID: post.ID})
+	}
+
+	projects, err := h.projectsRepo.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		entities = append(entities, models.SyncedFile{Type: contentsync.TypeProject, ID: project.ID})
+	}
+
+	skills, err := h.skillsRepo.GetAllSkills()
+	if err != nil {
+		return nil, err
+	}
+	for _, skill := range skills {
+		entities = append(entities, models.SyncedFile{Type: contentsync.TypeSkill, ID: skill.ID})
+	}
+
+	return entities, nil
+}
+
+// removeContentFiles - posts/, projects/, skills/ altındaki içerik dosyalarını sil
+// Repository'deki diğer dosyalara (README, .gitignore...) dokunulmaz
+func removeContentFiles(dir string) error {
+	for _, sub := range []string{archive.PostsDir, archive.ProjectsDir, archive.SkillsDir} {
+		root := filepath.Join(dir, sub)
+		err := filepath.WalkDir(root, func(diskPath string, d fs.DirEntry, err error) error {
+			if os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			rel, err := filepath.Rel(dir, diskPath)
+			if err != nil {
+				return err
+			}
+			if contentsync.Classify(filepath.ToSlash(rel)) == "" {
+				return nil
+			}
+			return os.Remove(diskPath)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to clean %s: %w", sub, err)
+		}
+	}
+	return nil
+}
+
+// backupMessage - "Content backup: 2 posts updated, 1 skill removed" + dosya listesi
+func backupMessage(changes []gitrepo.Change) string {
+	verbs := map[string]string{gitrepo.Added: "added", gitrepo.Modified: "updated", gitrepo.Deleted: "removed"}
+	counts := make(map[string]int)
+	for _, change := range changes {
+		counts[contentsync.Classify(change.Path)+"|"+change.Status]++
+	}
+
+	var parts []string
+	for _, fileType := range []string{contentsync.TypePost, contentsync.TypeProject, contentsync.TypeSkill, ""} {
+		for _, status := range []string{gitrepo.Added, gitrepo.Modified, gitrepo.Deleted} {
+			count := counts[fileType+"|"+status]
+			if count == 0 {
+				continue
+			}
+			noun := fileType
+			if noun == "" {
+				noun = "other file"
+			}
+			if count > 1 {
+				noun += "s"
+			}
+			parts = append(parts, fmt.Sprintf("%d %s %s", count, noun, verbs[status]))
+		}
+	}
+
+	var body strings.Builder
+	for _, change := range changes {
+		fmt.Fprintf(&body, "%s %s\n", change.Status, change.Path)
+	}
+
+	return "Content backup: " + strings.Join(parts, ", ") + "\n\n" + body.String()
+}
@@ -5,7 +5,12 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"portfolio-backend/config"
+	"portfolio-backend/events"
+	"portfolio-backend/frontmatter"
+	"portfolio-backend/markdown"
 	"portfolio-backend/models"
+	"portfolio-backend/newsletter"
 	"sort"
 	"strconv"
 	"strings"
@@ -19,13 +24,26 @@ import (
 
 // BlogHandler - Blog endpoint'leri için handler
 type BlogHandler struct {
-	blogRepo *models.BlogRepository
+	config        *config.Config
+	blogRepo      *models.BlogRepository
+	reactionsRepo *models.ReactionsRepository
+	newsletter    *newsletter.Service
+	dialects      *frontmatter.Registry
 }
 
 // NewBlogHandler - Yeni handler oluştur
-func NewBlogHandler(redisClient *redis.Client) *BlogHandler {
+func NewBlogHandler(cfg *config.Config, redisClient *redis.Client) *BlogHandler {
+	dialects, err := frontmatter.NewRegistry(cfg.Frontmatter.DialectsFile)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load frontmatter dialects, using built-in mappings: %v\n", err)
+	}
+
 	return &BlogHandler{
-		blogRepo: models.NewBlogRepository(redisClient),
+		config:        cfg,
+		blogRepo:      models.NewBlogRepository(redisClient),
+		reactionsRepo: models.NewReactionsRepository(redisClient),
+		newsletter:    newsletter.NewService(cfg, redisClient),
+		dialects:      dialects,
 	}
 }
 
@@ -140,8 +158,16 @@ func (h *BlogHandler) GetPostBySlug(c *gin.Context) {
 	// View count'u artır
 	h.blogRepo.IncrementPostViews(post.ID)
 
+	// Reaction sayıları - hata olursa post yine de dönsün
+	counts, err := h.reactionsRepo.GetReactionCounts(post.ID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to get reactions for %s: %v\n", post.ID, err)
+		counts = map[string]int{}
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"post": post,
+		"post":      post,
+		"reactions": models.NewPostReactions(post.ID, h.config.Reactions.Types, counts),
 	})
 }
 
@@ -282,6 +308,8 @@ func (h *BlogHandler) CreatePost(c *gin.Context) {
 		return
 	}
 
+	events.Publish(events.PostCreated, post)
+
 	// HTTP 201 Created
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "Blog post created successfully",
@@ -333,6 +361,10 @@ func (h *BlogHandler) UpdatePost(c *gin.Context) {
 	if len(request.Tags) > 0 {
 		existingPost.Tags = request.Tags
 	}
+	// Draft -> published geçişi mi? (newsletter ve webhook'lar için)
+	justPublished := !existingPost.Published && request.Published
+	justUnpublished := existingPost.Published && !request.Published
+
 	// Featured boolean olduğu için her zaman güncelle
 	existingPost.Featured = request.Featured
 	// Published boolean olduğu için her zaman güncelle
@@ -354,6 +386,18 @@ func (h *BlogHandler) UpdatePost(c *gin.Context) {
 		return
 	}
 
+	post := *existingPost
+	events.Publish(events.PostUpdated, &post)
+
+	// Yeni yayına alındıysa abonelere bildir (arka planda)
+	if justPublished {
+		events.Publish(events.PostPublished, &post)
+		go h.newsletter.NotifyNewPost(&post)
+	}
+	if justUnpublished {
+		events.Publish(events.PostUnpublished, &post)
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Blog post updated successfully",
 		"post":    existingPost,
@@ -365,8 +409,8 @@ func (h *BlogHandler) UpdatePost(c *gin.Context) {
 func (h *BlogHandler) DeletePost(c *gin.Context) {
 	postID := c.Param("id")
 
-	// Önce var mı kontrol et
-	_, err := h.blogRepo.GetPostByID(postID)
+	// Önce var mı kontrol et (silinen hali event ile gönderilir)
+	post, err := h.blogRepo.GetPostByID(postID)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "Blog post not found",
@@ -383,6 +427,13 @@ func (h *BlogHandler) DeletePost(c *gin.Context) {
 		return
 	}
 
+	// Reaction verilerini temizle
+	if err := h.reactionsRepo.DeleteReactions(postID); err != nil {
+		fmt.Printf("Warning: Failed to delete reactions for %s: %v\n", postID, err)
+	}
+
+	events.Publish(events.PostDeleted, post)
+
 	// HTTP 204 No Content
 	c.Status(http.StatusNoContent)
 }
@@ -405,6 +456,114 @@ func (h *BlogHandler) IncrementPostViews(c *gin.Context) {
 	})
 }
 
+// AddReaction - Post'a anonim reaction ekle
+// POST /api/v1/blog/posts/:id/reactions
+// Body: {"type": "like"}
+func (h *BlogHandler) AddReaction(c *gin.Context) {
+	postID := c.Param("id")
+
+	var request struct {
+		Type string `json:"type" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Sadece config'deki tipler kabul edilir
+	if !models.IsValidReactionType(h.config.Reactions.Types, request.Type) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":         "Invalid reaction type",
+			"allowed_types": h.config.Reactions.Types,
+		})
+		return
+	}
+
+	// Post var mı kontrol et (draft'lara reaction verilmez)
+	post, err := h.blogRepo.GetPostByID(postID)
+	if err != nil || !post.Published {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Blog post not found",
+		})
+		return
+	}
+
+	// Dedupe window
+	window, err := time.ParseDuration(h.config.Reactions.DedupeWindow)
+	if err != nil {
+		window = 24 * time.Hour
+	}
+
+	fingerprint := models.VisitorFingerprint(h.config.Reactions.Salt, c.ClientIP(), c.GetHeader("User-Agent"))
+
+	counted, err := h.reactionsRepo.AddReaction(post.ID, request.Type, fingerprint, window)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to add reaction",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	counts, err := h.reactionsRepo.GetReactionCounts(post.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get reactions",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	message := "Reaction added successfully"
+	if !counted {
+		message = "Reaction already recorded for this visitor"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   message,
+		"counted":   counted,
+		"reactions": models.NewPostReactions(post.ID, h.config.Reactions.Types, counts),
+	})
+}
+
+// GetMostReactedPosts - En çok reaction alan post'lar (admin raporu)
+// GET /api/v1/blog/admin/reactions?count=10
+func (h *BlogHandler) GetMostReactedPosts(c *gin.Context) {
+	countStr := c.DefaultQuery("count", "10")
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count <= 0 {
+		count = 10
+	}
+
+	stats, err := h.reactionsRepo.GetMostReacted(count)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get reaction report",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Post bilgilerini ekle
+	for i := range stats {
+		if post, err := h.blogRepo.GetPostByID(stats[i].PostID); err == nil {
+			stats[i].Slug = post.Slug
+			stats[i].Title = post.Title
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Reaction report retrieved successfully",
+		"count":   len(stats),
+		"posts":   stats,
+		"types":   h.config.Reactions.Types,
+	})
+}
+
 // MigratePosts - V1'den bulk post migration
 // POST /api/blog/posts/migrate
 // Şimdilik tek tek create edelim, bulk method yok
@@ -447,22 +606,33 @@ func (h *BlogHandler) MigratePosts(c *gin.Context) {
 }
 
 // MD Import Structures
+// Pointer alanlar: key hiç yoksa default uygulanır, boş string ise olduğu gibi korunur
+// Bilinmeyen key'ler Extras'a düşer ve export'ta geri yazılır
 type MDFrontmatter struct {
-	Title         string    `yaml:"title"`
-	Excerpt       string    `yaml:"excerpt"`
-	Author        string    `yaml:"author"`
-	PublishedAt   string    `yaml:"publishedAt"`
-	Tags          []string  `yaml:"tags"`
-	ReadingTime   string    `yaml:"readingTime"`
-	ViewCount     int       `yaml:"viewCount"`
-	Featured      bool      `yaml:"featured"`
-	Slug          string    `yaml:"slug"`
-	FeaturedImage string    `yaml:"featuredImage"`
+	Title           string                 `yaml:"title"`
+	Excerpt         *string                `yaml:"excerpt"`
+	Author          *string                `yaml:"author"`
+	PublishedAt     string                 `yaml:"publishedAt"`
+	UpdatedAt       string                 `yaml:"updatedAt,omitempty"`
+	Tags            []string               `yaml:"tags"`
+	ReadingTime     *string                `yaml:"readingTime"`
+	ViewCount       int                    `yaml:"viewCount"`
+	Featured        bool                   `yaml:"featured"`
+	Published       *bool                  `yaml:"published"`
+	Slug            string                 `yaml:"slug"`
+	FeaturedImage   string                 `yaml:"featuredImage"`
+	MetaDescription *string                `yaml:"metaDescription"`
+	MetaKeywords    string                 `yaml:"metaKeywords"`
+	Extras          map[string]interface{} `yaml:",inline"`
 }
 
+// Dialect boş veya "auto" ise frontmatter key'lerinden tespit edilir (hugo, jekyll, astro, native)
+// FieldMap dialect mapping'ini bu istek için override eder: {"featuredImage": ["cover"]}
 type MDImportRequest struct {
-	Content  string `json:"content" binding:"required"`
-	Filename string `json:"filename"`
+	Content  string              `json:"content" binding:"required"`
+	Filename string              `json:"filename"`
+	Dialect  string              `json:"dialect,omitempty"`
+	FieldMap map[string][]string `json:"field_map,omitempty"`
 }
 
 type MDImportResult struct {
@@ -471,10 +641,12 @@ type MDImportResult struct {
 	Slug     string   `json:"slug,omitempty"`
 	Error    string   `json:"error,omitempty"`
 	PostID   string   `json:"post_id,omitempty"`
+	Dialect  string   `json:"dialect,omitempty"`
 }
 
 // ImportMD - Tek MD dosyası import et
-// POST /api/v1/blog/import-md
+// POST /api/v1/blog/import-md?dry_run=true
+// dry_run: Redis'e yazmadan create/conflict planı ve mevcut post'a göre alan bazlı diff döner
 func (h *BlogHandler) ImportMD(c *gin.Context) {
 	var request MDImportRequest
 	
@@ -486,8 +658,17 @@ func (h *BlogHandler) ImportMD(c *gin.Context) {
 		return
 	}
 
+	if isDryRun(c) {
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Dry run completed, nothing was written",
+			"dry_run": true,
+			"plan":    h.planMDImport(request, make(map[string]string)),
+		})
+		return
+	}
+
 	// MD dosyasını parse et
-	post, err := h.parseMDContent(request.Content, request.Filename)
+	post, dialect, err := h.parseMDFile(request)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Failed to parse MD content",
@@ -516,8 +697,11 @@ func (h *BlogHandler) ImportMD(c *gin.Context) {
 		return
 	}
 
+	h.publishImported(post)
+
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "MD file imported successfully",
+		"dialect": dialect,
 		"post": gin.H{
 			"id":    post.ID,
 			"slug":  post.Slug,
@@ -527,10 +711,14 @@ func (h *BlogHandler) ImportMD(c *gin.Context) {
 }
 
 // ImportBulkMD - Çoklu MD dosya import et
-// POST /api/v1/blog/import-bulk
+// POST /api/v1/blog/import-bulk?dry_run=true
+// Dialect her dosya için ayrı tespit edilir; request seviyesindeki dialect/field_map
+// kendi değeri olmayan dosyalara uygulanır
 func (h *BlogHandler) ImportBulkMD(c *gin.Context) {
 	var request struct {
-		Files []MDImportRequest `json:"files" binding:"required"`
+		Files    []MDImportRequest   `json:"files" binding:"required"`
+		Dialect  string              `json:"dialect"`
+		FieldMap map[string][]string `json:"field_map"`
 	}
 	
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -541,6 +729,43 @@ func (h *BlogHandler) ImportBulkMD(c *gin.Context) {
 		return
 	}
 
+	if err := h.validateDialect(request.Dialect, request.FieldMap); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid frontmatter dialect",
+			"details": err.Error(),
+		})
+		return
+	}
+	for i := range request.Files {
+		if request.Files[i].Dialect == "" {
+			request.Files[i].Dialect = request.Dialect
+		}
+		if request.Files[i].FieldMap == nil {
+			request.Files[i].FieldMap = request.FieldMap
+		}
+	}
+
+	if isDryRun(c) {
+		plans := make([]MDImportPlan, 0, len(request.Files))
+		summary := make(map[string]int)
+		batch := make(map[string]string)
+
+		for _, file := range request.Files {
+			plan := h.planMDImport(file, batch)
+			summary[plan.Action]++
+			plans = append(plans, plan)
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Dry run completed, nothing was written",
+			"dry_run": true,
+			"total_files": len(request.Files),
+			"summary": summary,
+			"plans": plans,
+		})
+		return
+	}
+
 	var results []MDImportResult
 	successCount := 0
 
@@ -550,7 +775,8 @@ func (h *BlogHandler) ImportBulkMD(c *gin.Context) {
 		}
 
 		// MD dosyasını parse et
-		post, err := h.parseMDContent(file.Content, file.Filename)
+		post, dialect, err := h.parseMDFile(file)
+		result.Dialect = dialect
 		if err != nil {
 			result.Success = false
 			result.Error = fmt.Sprintf("Parse error: %s", err.Error())
@@ -576,6 +802,8 @@ func (h *BlogHandler) ImportBulkMD(c *gin.Context) {
 			continue
 		}
 
+		h.publishImported(post)
+
 		result.Success = true
 		result.Slug = post.Slug
 		result.PostID = post.ID
@@ -592,6 +820,15 @@ func (h *BlogHandler) ImportBulkMD(c *gin.Context) {
 	})
 }
 
+// GetImportDialects - Desteklenen frontmatter dialect'leri ve field mapping'leri
+// GET /api/v1/blog/import-dialects
+func (h *BlogHandler) GetImportDialects(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"dialects": h.dialects.All(),
+		"targets":  frontmatter.Targets,
+	})
+}
+
 // ExportMD - Blog post'u MD formatında export et
 // GET /api/v1/blog/export-md/:slug
 func (h *BlogHandler) ExportMD(c *gin.Context) {
@@ -606,7 +843,14 @@ func (h *BlogHandler) ExportMD(c *gin.Context) {
 	}
 
 	// MD format'ına çevir
-	mdContent := h.convertToMD(post)
+	mdContent, err := h.convertToMD(post)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to export blog post",
+			"details": err.Error(),
+		})
+		return
+	}
 
 	// File download header'ları
 	filename := fmt.Sprintf("%s.md", post.Slug)
@@ -616,46 +860,136 @@ func (h *BlogHandler) ExportMD(c *gin.Context) {
 	c.String(http.StatusOK, mdContent)
 }
 
-// parseMDContent - MD içeriğini BlogPost'a parse et
+// parseMDContent - MD içeriğini BlogPost'a parse et (dialect otomatik tespit edilir)
 func (h *BlogHandler) parseMDContent(content, filename string) (*models.BlogPost, error) {
+	post, _, err := h.parseMDFile(MDImportRequest{Content: content, Filename: filename})
+	return post, err
+}
+
+// parseMDFile - MD dosyasını istenen (veya tespit edilen) dialect ile parse et
+// Kullanılan dialect'in adı da döner
+func (h *BlogHandler) parseMDFile(file MDImportRequest) (*models.BlogPost, string, error) {
 	// Frontmatter ve content'i ayır
-	frontmatter, markdownContent, err := h.extractFrontmatter(content)
+	doc, err := frontmatter.Split(file.Content)
 	if err != nil {
-		return nil, fmt.Errorf("frontmatter extraction failed: %w", err)
+		return nil, "", fmt.Errorf("frontmatter extraction failed: %w", err)
 	}
 
-	// Frontmatter'ı parse et
-	var fm MDFrontmatter
-	err = yaml.Unmarshal([]byte(frontmatter), &fm)
+	fields, err := doc.Decode()
 	if err != nil {
+		return nil, "", err
+	}
+
+	dialect, err := h.resolveDialect(file, doc.Format, fields)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fm, err := h.decodeFrontmatter(doc, fields, dialect, file.Filename)
+	if err != nil {
+		return nil, dialect.Name, err
+	}
+
+	post, err := h.buildPost(fm, doc.Body)
+	return post, dialect.Name, err
+}
+
+// resolveDialect - İstenen dialect (boş/auto ise tespit edilen) + request field mapping'i
+func (h *BlogHandler) resolveDialect(file MDImportRequest, format string, fields map[string]interface{}) (*frontmatter.Dialect, error) {
+	if err := h.validateDialect(file.Dialect, file.FieldMap); err != nil {
+		return nil, err
+	}
+
+	var dialect *frontmatter.Dialect
+	if file.Dialect == "" || file.Dialect == frontmatter.Auto {
+		dialect = h.dialects.Detect(format, fields, file.Filename)
+	} else {
+		dialect, _ = h.dialects.Get(file.Dialect)
+	}
+
+	if len(file.FieldMap) > 0 {
+		dialect = dialect.WithFields(file.FieldMap)
+	}
+	return dialect, nil
+}
+
+// validateDialect - Dialect adı ve field mapping hedefleri geçerli mi
+func (h *BlogHandler) validateDialect(name string, fieldMap map[string][]string) error {
+	if name != "" && name != frontmatter.Auto {
+		if _, err := h.dialects.Get(name); err != nil {
+			return err
+		}
+	}
+	return frontmatter.ValidateFields(fieldMap)
+}
+
+// decodeFrontmatter - Native YAML doğrudan MDFrontmatter'a okunur (lossless round-trip),
+// diğer dialect'ler native key'lere map'lenip YAML üzerinden aynı struct'a okunur
+func (h *BlogHandler) decodeFrontmatter(doc *frontmatter.Document, fields map[string]interface{}, dialect *frontmatter.Dialect, filename string) (*MDFrontmatter, error) {
+	raw := []byte(doc.Raw)
+	if doc.Format != frontmatter.FormatYAML || dialect.Name != frontmatter.Native || len(dialect.Fields) > 0 {
+		mapped, err := yaml.Marshal(dialect.Map(fields, filename))
+		if err != nil {
+			return nil, fmt.Errorf("frontmatter mapping failed: %w", err)
+		}
+		raw = mapped
+	}
+
+	var fm MDFrontmatter
+	if err := yaml.Unmarshal(raw, &fm); err != nil {
 		return nil, fmt.Errorf("frontmatter parsing failed: %w", err)
 	}
+	return &fm, nil
+}
 
+// buildPost - Parse edilmiş frontmatter ve markdown'dan BlogPost oluştur
+func (h *BlogHandler) buildPost(fm *MDFrontmatter, markdownContent string) (*models.BlogPost, error) {
 	// Required field validations
 	if fm.Title == "" {
 		return nil, fmt.Errorf("title is required")
 	}
 
-	// Default values
-	if fm.Author == "" {
-		fm.Author = "Serkan Ursavaş"
+	// Default values (sadece key hiç verilmemişse)
+	if fm.Author == nil {
+		author := "Serkan Ursavaş"
+		fm.Author = &author
 	}
 	if fm.Slug == "" {
 		fm.Slug = h.generateSlug(fm.Title)
 	}
-	if fm.Excerpt == "" {
-		fm.Excerpt = h.generateExcerpt(markdownContent)
+	if fm.Excerpt == nil {
+		excerpt := h.generateExcerpt(markdownContent)
+		fm.Excerpt = &excerpt
+	}
+	if fm.ReadingTime == nil {
+		readingTime := h.calculateReadingTime(markdownContent)
+		fm.ReadingTime = &readingTime
 	}
-	if fm.ReadingTime == "" {
-		fm.ReadingTime = h.calculateReadingTime(markdownContent)
+	if fm.MetaDescription == nil {
+		fm.MetaDescription = fm.Excerpt
+	}
+	if fm.Published == nil {
+		published := true // MD'den import ediliyorsa published kabul et
+		fm.Published = &published
 	}
 
 	// Date parsing
 	publishedAt := time.Now()
 	if fm.PublishedAt != "" {
-		if parsed, err := time.Parse("2006-01-02", fm.PublishedAt); err == nil {
-			publishedAt = parsed
+		parsed, err := frontmatter.ParseTime(fm.PublishedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid publishedAt: %w", err)
+		}
+		publishedAt = parsed
+	}
+
+	updatedAt := time.Now()
+	if fm.UpdatedAt != "" {
+		parsed, err := frontmatter.ParseTime(fm.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid updatedAt: %w", err)
 		}
+		updatedAt = parsed
 	}
 
 	// Image path processing - relative paths'i absolute'a çevir
@@ -667,172 +1001,123 @@ func (h *BlogHandler) parseMDContent(content, filename string) (*models.BlogPost
 		Title:           fm.Title,
 		Slug:            fm.Slug,
 		Content:         processedContent,
-		Excerpt:         fm.Excerpt,
-		Author:          fm.Author,
+		Excerpt:         *fm.Excerpt,
+		Author:          *fm.Author,
 		PublishedAt:     publishedAt,
-		UpdatedAt:       time.Now(),
+		UpdatedAt:       updatedAt,
 		Tags:            fm.Tags,
-		ReadingTime:     fm.ReadingTime,
+		ReadingTime:     *fm.ReadingTime,
 		ViewCount:       fm.ViewCount,
 		Featured:        fm.Featured,
 		FeaturedImage:   fm.FeaturedImage,
-		Published:       true, // MD'den import ediliyorsa published kabul et
-		MetaDescription: fm.Excerpt,
+		Published:       *fm.Published,
+		MetaDescription: *fm.MetaDescription,
+		MetaKeywords:    fm.MetaKeywords,
+		Extras:          normalizeExtras(fm.Extras),
 	}
 
 	return post, nil
 }
 
-// extractFrontmatter - MD içeriğinden frontmatter'ı ayır
-func (h *BlogHandler) extractFrontmatter(content string) (string, string, error) {
-	content = strings.TrimSpace(content)
-	
-	// Frontmatter başlangıcını kontrol et
-	if !strings.HasPrefix(content, "---") {
-		return "", "", fmt.Errorf("frontmatter must start with ---")
-	}
-	
-	// İkinci --- işaretini bul
-	lines := strings.Split(content, "\n")
-	var frontmatterEnd int = -1
-	
-	for i := 1; i < len(lines); i++ {
-		if strings.TrimSpace(lines[i]) == "---" {
-			frontmatterEnd = i
-			break
-		}
+// publishImported - MD'den import edilen post için event'ler
+func (h *BlogHandler) publishImported(post *models.BlogPost) {
+	events.Publish(events.PostCreated, post)
+	if post.Published {
+		events.Publish(events.PostPublished, post)
 	}
-	
-	if frontmatterEnd == -1 {
-		return "", "", fmt.Errorf("frontmatter must end with ---")
+}
+
+// replacePost - Mevcut post'u import/sync edilen hali ile değiştir
+func (h *BlogHandler) replacePost(existing, post *models.BlogPost) error {
+	if err := h.blogRepo.ReplacePost(existing, post); err != nil {
+		return err
 	}
-	
-	// Frontmatter ve content'i ayır
-	frontmatterLines := lines[1:frontmatterEnd]
-	frontmatter := strings.Join(frontmatterLines, "\n")
-	
-	var markdownContent string
-	if frontmatterEnd+1 < len(lines) {
-		contentLines := lines[frontmatterEnd+1:]
-		markdownContent = strings.Join(contentLines, "\n")
+
+	events.Publish(events.PostUpdated, post)
+	if !existing.Published && post.Published {
+		events.Publish(events.PostPublished, post)
 	}
-	
-	return strings.TrimSpace(frontmatter), strings.TrimSpace(markdownContent), nil
+	return nil
 }
 
 // convertToMD - BlogPost'u MD formatına çevir
-func (h *BlogHandler) convertToMD(post *models.BlogPost) string {
-	// Frontmatter oluştur
-	frontmatter := fmt.Sprintf(`---
-title: "%s"
-excerpt: "%s"
-author: "%s"
-publishedAt: "%s"
-tags: [%s]
-readingTime: "%s"
-viewCount: %d
-featured: %t
-slug: "%s"
-featuredImage: "%s"
----
-
-%s`,
-		post.Title,
-		post.Excerpt,
-		post.Author,
-		post.PublishedAt.Format("2006-01-02"),
-		h.formatTags(post.Tags),
-		post.ReadingTime,
-		post.ViewCount,
-		post.Featured,
-		post.Slug,
-		post.FeaturedImage,
-		post.Content,
-	)
-
-	return frontmatter
+// Frontmatter yaml.Marshal ile üretilir (tırnak, iki nokta vb. güvenli), tüm alanlar ve Extras dahil
+// Frontmatter üretilemezse hata döner (yarım dosya export/backup'a yazılmasın)
+func (h *BlogHandler) convertToMD(post *models.BlogPost) (string, error) {
+	published := post.Published
+	fm := MDFrontmatter{
+		Title:           post.Title,
+		Excerpt:         &post.Excerpt,
+		Author:          &post.Author,
+		PublishedAt:     frontmatter.FormatTime(post.PublishedAt),
+		UpdatedAt:       frontmatter.FormatTime(post.UpdatedAt),
+		Tags:            post.Tags,
+		ReadingTime:     &post.ReadingTime,
+		ViewCount:       post.ViewCount,
+		Featured:        post.Featured,
+		Published:       &published,
+		Slug:            post.Slug,
+		FeaturedImage:   post.FeaturedImage,
+		MetaDescription: &post.MetaDescription,
+		MetaKeywords:    post.MetaKeywords,
+		Extras:          post.Extras,
+	}
+	if fm.Tags == nil {
+		fm.Tags = []string{}
+	}
+
+	var buf strings.Builder
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&fm); err != nil {
+		// Extras'ta marshal edilemeyen bir değer olmadıkça olmaz
+		return "", fmt.Errorf("failed to marshal frontmatter for %s: %w", post.Slug, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal frontmatter for %s: %w", post.Slug, err)
+	}
+
+	return "---\n" + buf.String() + "---\n\n" + post.Content + "\n", nil
 }
 
-// Helper functions
-func (h *BlogHandler) generateSlug(title string) string {
-	slug := strings.ToLower(title)
-	slug = strings.ReplaceAll(slug, " ", "-")
-	slug = regexp.MustCompile(`[^a-z0-9\-]`).ReplaceAllString(slug, "")
-	slug = regexp.MustCompile(`-+`).ReplaceAllString(slug, "-")
-	slug = strings.Trim(slug, "-")
-	return slug
+// normalizeExtras - YAML tarihleri (time.Time) frontmatter formatında string'e çevrilir
+// Redis'teki JSON ile export'taki YAML aynı değeri taşısın
+func normalizeExtras(extras map[string]interface{}) map[string]interface{} {
+	for key, value := range extras {
+		extras[key] = normalizeExtraValue(value)
+	}
+	return extras
 }
 
-func (h *BlogHandler) generateExcerpt(content string) string {
-	// İlk paragrafı al
-	lines := strings.Split(content, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if len(line) > 10 && !strings.HasPrefix(line, "#") {
-			if len(line) > 150 {
-				return line[:150] + "..."
-			}
-			return line
+func normalizeExtraValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		return frontmatter.FormatTime(v)
+	case map[string]interface{}:
+		return normalizeExtras(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeExtraValue(v[i])
 		}
 	}
-	return "No excerpt available"
+	return value
 }
 
-func (h *BlogHandler) calculateReadingTime(content string) string {
-	words := strings.Fields(content)
-	wordCount := len(words)
-	minutes := wordCount / 200 // 200 words per minute
-	
-	if minutes < 1 {
-		return "1 min read"
-	}
-	return fmt.Sprintf("%d min read", minutes)
+// Helper functions - markdown analizi proje case study'leri ile ortak (markdown paketi)
+func (h *BlogHandler) generateSlug(title string) string {
+	return markdown.Slug(title)
 }
 
-func (h *BlogHandler) formatTags(tags []string) string {
-	if len(tags) == 0 {
-		return ""
-	}
-	
-	quoted := make([]string, len(tags))
-	for i, tag := range tags {
-		quoted[i] = fmt.Sprintf(`"%s"`, tag)
-	}
-	return strings.Join(quoted, ", ")
+func (h *BlogHandler) generateExcerpt(content string) string {
+	return markdown.Excerpt(content)
+}
+
+func (h *BlogHandler) calculateReadingTime(content string) string {
+	return markdown.ReadingTime(content)
 }
 
 func (h *BlogHandler) processImagePaths(content string) string {
-	// Markdown image pattern: ![alt](path)
-	imagePattern := `!\[([^\]]*)\]\(([^)]+)\)`
-	re := regexp.MustCompile(imagePattern)
-	
-	return re.ReplaceAllStringFunc(content, func(match string) string {
-		submatch := re.FindStringSubmatch(match)
-		if len(submatch) != 3 {
-			return match
-		}
-		
-		alt := submatch[1]
-		path := submatch[2]
-		
-		// Skip if already absolute URL or data URI
-		if strings.HasPrefix(path, "http://") || 
-		   strings.HasPrefix(path, "https://") || 
-		   strings.HasPrefix(path, "data:") {
-			return match
-		}
-		
-		// Convert relative paths to absolute
-		// Assuming images are in /public directory
-		if strings.HasPrefix(path, "/") {
-			// Already absolute from root
-			return match
-		} else {
-			// Relative path - convert to absolute
-			absolutePath := "/" + strings.TrimPrefix(path, "./")
-			return fmt.Sprintf("![%s](%s)", alt, absolutePath)
-		}
-	})
+	return markdown.ResolveImagePaths(content)
 }
 
 // deleteBlogImageFile - Blog slug'ına göre resim dosyasını sil
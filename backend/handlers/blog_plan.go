@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MD import plan action'ları (dry run)
+const (
+	PlanCreate   = "create"   // Slug yok, post oluşturulacak
+	PlanUpdate   = "update"   // Slug var, üzerine yazılacak (WordPress import'u, overwrite=true)
+	PlanConflict = "conflict" // Slug var, import reddedilecek (409)
+	PlanInvalid  = "invalid"  // Parse/validation hatası
+)
+
+// diffPreviewLength - Diff'te gösterilecek maksimum string uzunluğu (content gibi uzun alanlar için)
+const diffPreviewLength = 200
+
+// FieldDiff - Tek alan değişikliği
+type FieldDiff struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// MDImportPlan - Dry run'da tek dosya için yapılacak işlem
+type MDImportPlan struct {
+	Filename string      `json:"filename"`
+	Slug     string      `json:"slug,omitempty"`
+	PostID   string      `json:"post_id,omitempty"`
+	Action   string      `json:"action"`
+	Dialect  string      `json:"dialect,omitempty"`
+	Error    string      `json:"error,omitempty"`
+	Changes  []FieldDiff `json:"changes,omitempty"`
+}
+
+// planMDImport - MD dosyasını parse et ve mevcut post ile karşılaştır (Redis'e yazmaz)
+// batch: aynı istekte daha önce görülen slug'lar (slug -> filename)
+func (h *BlogHandler) planMDImport(file MDImportRequest, batch map[string]string) MDImportPlan {
+	plan := MDImportPlan{Filename: file.Filename}
+
+	post, dialect, err := h.parseMDFile(file)
+	plan.Dialect = dialect
+	if err != nil {
+		plan.Action = PlanInvalid
+		plan.Error = fmt.Sprintf("Parse error: %s", err.Error())
+		return plan
+	}
+	plan.Slug = post.Slug
+	plan.PostID = post.ID
+
+	if previous, ok := batch[post.Slug]; ok {
+		plan.Action = PlanConflict
+		plan.Error = fmt.Sprintf("Slug '%s' is also used by %s in this request", post.Slug, previous)
+		return plan
+	}
+	batch[post.Slug] = file.Filename
+
+	existing, _ := h.blogRepo.GetPostBySlug(post.Slug)
+	if existing == nil {
+		plan.Action = PlanCreate
+		return plan
+	}
+
+	// Import mevcut slug'ı reddeder; diff dosyanın mevcut post'tan farkını gösterir
+	plan.Changes = diffPosts(existing, post)
+	plan.Action = PlanConflict
+	plan.Error = fmt.Sprintf("Slug '%s' already exists", post.Slug)
+
+	return plan
+}
+
+// diffPosts - Import'un değiştireceği alanlar
+// PublishedAt frontmatter'da gün hassasiyetinde olduğu için gün bazında karşılaştırılır
+func diffPosts(existing, incoming *models.BlogPost) []FieldDiff {
+	var changes []FieldDiff
+
+	add := func(field string, old, new interface{}) {
+		if !reflect.DeepEqual(old, new) {
+			changes = append(changes, FieldDiff{Field: field, Old: preview(old), New: preview(new)})
+		}
+	}
+
+	add("title", existing.Title, incoming.Title)
+	add("excerpt", existing.Excerpt, incoming.Excerpt)
+	add("author", existing.Author, incoming.Author)
+	add("published_at", existing.PublishedAt.Format("2006-01-02"), incoming.PublishedAt.Format("2006-01-02"))
+	add("tags", normalizeTags(existing.Tags), normalizeTags(incoming.Tags))
+	add("reading_time", existing.ReadingTime, incoming.ReadingTime)
+	add("view_count", existing.ViewCount, incoming.ViewCount)
+	add("featured", existing.Featured, incoming.Featured)
+	add("published", existing.Published, incoming.Published)
+	add("featured_image", existing.FeaturedImage, incoming.FeaturedImage)
+	add("meta_description", existing.MetaDescription, incoming.MetaDescription)
+	add("meta_keywords", existing.MetaKeywords, incoming.MetaKeywords)
+	add("extras", normalizeExtrasJSON(existing.Extras), normalizeExtrasJSON(incoming.Extras))
+	add("content", existing.Content, incoming.Content)
+
+	return changes
+}
+
+// isDryRun - ?dry_run=true
+func isDryRun(c *gin.Context) bool {
+	dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+	return dryRun
+}
+
+// isOverwrite - ?overwrite=true
+func isOverwrite(c *gin.Context) bool {
+	overwrite, _ := strconv.ParseBool(c.Query("overwrite"))
+	return overwrite
+}
+
+// Helper functions
+
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// normalizeExtrasJSON - Redis'ten okunan Extras'ta sayılar float64, import'ta int gelir
+// Karşılaştırma için iki taraf da JSON'dan geçirilir
+func normalizeExtrasJSON(extras map[string]interface{}) interface{} {
+	if len(extras) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(extras)
+	if err != nil {
+		return extras
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return extras
+	}
+	return normalized
+}
+
+func preview(value interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok || utf8.RuneCountInString(s) <= diffPreviewLength {
+		return value
+	}
+	return string([]rune(s)[:diffPreviewLength]) + "…"
+}
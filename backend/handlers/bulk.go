@@ -0,0 +1,422 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"portfolio-backend/bulk"
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// BulkItem - Import dosyasındaki tek satırın sonucu
+type BulkItem struct {
+	Row    int      `json:"row"` // 1'den başlar (CSV'de header hariç)
+	ID     string   `json:"id,omitempty"`
+	Action string   `json:"action"`           // created, updated, unchanged, failed
+	Fields []string `json:"fields,omitempty"` // updated ise değişen alanlar
+	Error  string   `json:"error,omitempty"`
+}
+
+// BulkReport - Bulk import sonucu (dry-run'da plan)
+// Valid false ise hiçbir satır yazılmaz
+type BulkReport struct {
+	Entity  string         `json:"entity"` // projects, skills
+	Format  string         `json:"format"`
+	DryRun  bool           `json:"dry_run"`
+	Valid   bool           `json:"valid"`
+	Summary map[string]int `json:"summary"`
+	Items   []BulkItem     `json:"items"`
+}
+
+// BulkHandler - Proje ve skill'leri YAML/JSON/CSV olarak toplu export/import eder (admin)
+type BulkHandler struct {
+	projectsRepo *models.ProjectsRepository
+	skillsRepo   *models.SkillsRepository
+	statusesRepo *models.ProjectStatusesRepository
+}
+
+// NewBulkHandler - Yeni handler oluştur
+func NewBulkHandler(redisClient *redis.Client) *BulkHandler {
+	return &BulkHandler{
+		projectsRepo: models.NewProjectsRepository(redisClient),
+		skillsRepo:   models.NewSkillsRepository(redisClient),
+		statusesRepo: models.NewProjectStatusesRepository(redisClient),
+	}
+}
+
+// ExportProjectsFile - Projeleri dosya olarak indir
+// GET /api/v1/admin/projects/export?format=yaml|json|csv
+func (h *BulkHandler) ExportProjectsFile(c *gin.Context) {
+	h.exportFile(c, "projects", h.ExportProjects)
+}
+
+// ExportSkillsFile - Skill'leri dosya olarak indir
+// GET /api/v1/admin/skills/export?format=yaml|json|csv
+func (h *BulkHandler) ExportSkillsFile(c *gin.Context) {
+	h.exportFile(c, "skills", h.ExportSkills)
+}
+
+// ImportProjectsFile - Projeleri dosyadan upsert et (ID'ye göre)
+// POST /api/v1/admin/projects/import?format=yaml|json|csv&dry_run=true
+// Body: multipart file=<dosya> veya raw body (format uzantı/Content-Type'tan da anlaşılır)
+func (h *BulkHandler) ImportProjectsFile(c *gin.Context) {
+	h.importFile(c, h.ImportProjects)
+}
+
+// ImportSkillsFile - Skill'leri dosyadan upsert et (ID'ye göre)
+// POST /api/v1/admin/skills/import?format=yaml|json|csv&dry_run=true
+func (h *BulkHandler) ImportSkillsFile(c *gin.Context) {
+	h.importFile(c, h.ImportSkills)
+}
+
+// ExportProjects - Tüm projeleri ID sırasıyla yaz
+func (h *BulkHandler) ExportProjects(w io.Writer, format string) error {
+	projects, err := h.projectsRepo.GetAllProjects()
+	if err != nil {
+		return err
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	return bulk.EncodeProjects(w, format, projects)
+}
+
+// ExportSkills - Tüm skill'leri ID sırasıyla yaz
+func (h *BulkHandler) ExportSkills(w io.Writer, format string) error {
+	skills, err := h.skillsRepo.GetAllSkills()
+	if err != nil {
+		return err
+	}
+	sort.Slice(skills, func(i, j int) bool { return skills[i].ID < skills[j].ID })
+	return bulk.EncodeSkills(w, format, skills)
+}
+
+// ImportProjects - Dosyadaki projeleri doğrula ve upsert et, sonra index'leri yeniden kur
+// Dosya okunamazsa error döner, satır hataları report'ta (Valid=false, hiçbir şey yazılmaz)
+func (h *BulkHandler) ImportProjects(r io.Reader, format string, dryRun bool) (*BulkReport, error) {
+	projects, err := bulk.DecodeProjects(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := h.statusesRepo.GetAllStatuses()
+	if err != nil {
+		return nil, err
+	}
+
+	report := newBulkReport("projects", format, dryRun)
+	seen := make(map[string]int)
+
+	for i := range projects {
+		project := &projects[i]
+		item := BulkItem{Row: i + 1}
+
+		if project.ID == "" && project.Title != "" {
+			project.ID = models.NewProject(project.Title, "", "", "", "", nil).ID
+		}
+		item.ID = project.ID
+
+		if err := project.Validate(); err != nil {
+			report.add(failedBulkItem(item, err))
+			continue
+		}
+		// "live" gibi yazımlar tanımlı key'e çevrilir
+		status := models.MatchProjectStatus(statuses, project.Status)
+		if status == nil {
+			report.add(failedBulkItem(item, fmt.Errorf("%w: %s", models.ErrUnknownStatus, project.Status)))
+			continue
+		}
+		project.Status = status.Key
+		if row, ok := seen[project.ID]; ok {
+			report.add(failedBulkItem(item, fmt.Errorf("duplicate id (also in row %d)", row)))
+			continue
+		}
+		seen[project.ID] = item.Row
+
+		current, err := h.projectsRepo.GetProjectByID(project.ID)
+		if err != nil {
+			if project.CreatedAt == "" {
+				project.CreatedAt = time.Now().Format("2006-01-02T15:04:05.000Z")
+			}
+			project.UpdatedAt = time.Now()
+			item.Action = ImportCreated
+			report.add(item)
+			continue
+		}
+
+		// Dosyada olmayan sayaç/tarih alanları mevcut değerden gelir
+		if project.ViewCount == 0 {
+			project.ViewCount = current.ViewCount
+		}
+		if project.CreatedAt == "" {
+			project.CreatedAt = current.CreatedAt
+		}
+		project.UpdatedAt = current.UpdatedAt
+		if len(project.Tools) == 0 && len(current.Tools) == 0 {
+			project.Tools = current.Tools // null ve [] aynı sayılsın
+		}
+		if format == bulk.FormatCSV {
+			// CSV'de body ve galeri kolonu yok
+			project.Gallery = current.Gallery
+			project.Body = current.Body
+		}
+		// Slug verilmediyse mevcut slug korunur, reading_time body'den hesaplanır
+		if project.Slug == "" {
+			project.Slug = current.Slug
+		}
+		project.PrepareBody()
+		// Dosyadaki tool'lar isimle gelir, mevcut referanslarla karşılaştırmadan önce bağla
+		if _, err := h.projectsRepo.LinkTools(project); err != nil {
+			return nil, err
+		}
+
+		item.Fields = changedFields(current, project)
+		item.Action = SyncUpdated
+		if len(item.Fields) == 0 {
+			item.Action = ImportUnchanged
+		}
+		report.add(item)
+	}
+
+	if dryRun || !report.Valid {
+		return report, nil
+	}
+
+	for i := range report.Items {
+		item := &report.Items[i]
+		project := &projects[i]
+
+		switch item.Action {
+		case ImportCreated:
+			if err := h.projectsRepo.CreateProject(project); err != nil {
+				return nil, fmt.Errorf("row %d: %w", item.Row, err)
+			}
+			events.Publish(events.ProjectCreated, project)
+		case SyncUpdated:
+			if err := h.projectsRepo.UpdateProject(project); err != nil {
+				return nil, fmt.Errorf("row %d: %w", item.Row, err)
+			}
+			events.Publish(events.ProjectUpdated, project)
+		}
+	}
+
+	if err := h.projectsRepo.RebuildIndexes(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ImportSkills - Dosyadaki skill'leri doğrula ve upsert et, sonra index'leri yeniden kur
+func (h *BulkHandler) ImportSkills(r io.Reader, format string, dryRun bool) (*BulkReport, error) {
+	skills, err := bulk.DecodeSkills(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	report := newBulkReport("skills", format, dryRun)
+	seen := make(map[string]int)
+
+	for i := range skills {
+		skill := &skills[i]
+		item := BulkItem{Row: i + 1}
+
+		if skill.ID == "" && skill.Category != "" && skill.Skill != "" {
+			skill.ID = models.NewSkill(skill.Category, skill.Skill, "").ID
+		}
+		item.ID = skill.ID
+
+		if err := skill.Validate(); err != nil {
+			report.add(failedBulkItem(item, err))
+			continue
+		}
+		if row, ok := seen[skill.ID]; ok {
+			report.add(failedBulkItem(item, fmt.Errorf("duplicate id (also in row %d)", row)))
+			continue
+		}
+		seen[skill.ID] = item.Row
+
+		current, err := h.skillsRepo.GetSkillByID(skill.ID)
+		if err != nil {
+			if skill.CreatedAt.IsZero() {
+				skill.CreatedAt = time.Now()
+			}
+			skill.UpdatedAt = time.Now()
+			item.Action = ImportCreated
+			report.add(item)
+			continue
+		}
+
+		// Kategori ID'nin parçası, mevcut skill'in kategorisi import ile değiştirilemez
+		if skill.Category != current.Category {
+			report.add(failedBulkItem(item, fmt.Errorf("%w: %s -> %s (use POST /api/v1/skills/:id/move)", models.ErrSkillCategoryChange, current.Category, skill.Category)))
+			continue
+		}
+
+		if skill.CreatedAt.IsZero() {
+			skill.CreatedAt = current.CreatedAt
+		}
+		skill.UpdatedAt = current.UpdatedAt
+
+		item.Fields = changedFields(current, skill)
+		item.Action = SyncUpdated
+		if len(item.Fields) == 0 {
+			item.Action = ImportUnchanged
+		}
+		report.add(item)
+	}
+
+	if dryRun || !report.Valid {
+		return report, nil
+	}
+
+	for i := range report.Items {
+		item := &report.Items[i]
+		skill := &skills[i]
+
+		switch item.Action {
+		case ImportCreated:
+			if err := h.skillsRepo.CreateSkill(skill); err != nil {
+				return nil, fmt.Errorf("row %d: %w", item.Row, err)
+			}
+			events.Publish(events.SkillCreated, skill)
+		case SyncUpdated:
+			if err := h.skillsRepo.UpdateSkill(skill); err != nil {
+				return nil, fmt.Errorf("row %d: %w", item.Row, err)
+			}
+			events.Publish(events.SkillUpdated, skill)
+		}
+	}
+
+	if err := h.skillsRepo.RebuildIndexes(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Helper functions
+
+func (h *BulkHandler) exportFile(c *gin.Context, entity string, export func(io.Writer, string) error) {
+	format, err := bulk.DetectFormat(c.DefaultQuery("format", bulk.FormatYAML), "", "")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          err.Error(),
+			"allowed_values": bulk.Formats,
+		})
+		return
+	}
+
+	// Hata olursa hâlâ JSON dönebilmek için önce belleğe yaz
+	var buf bytes.Buffer
+	if err := export(&buf, format); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   fmt.Sprintf("Failed to export %s", entity),
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", entity, time.Now().Format("20060102-150405"), format)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	c.Data(http.StatusOK, bulk.ContentTypes[format], buf.Bytes())
+}
+
+func (h *BulkHandler) importFile(c *gin.Context, importer func(io.Reader, string, bool) (*BulkReport, error)) {
+	dryRun, _ := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+
+	var body io.Reader = c.Request.Body
+	filename := ""
+	if header, err := c.FormFile("file"); err == nil {
+		file, err := header.Open()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to open uploaded file",
+				"details": err.Error(),
+			})
+			return
+		}
+		defer file.Close()
+		body = file
+		filename = header.Filename
+	}
+
+	format, err := bulk.DetectFormat(c.Query("format"), filename, c.ContentType())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          err.Error(),
+			"allowed_values": bulk.Formats,
+		})
+		return
+	}
+
+	report, err := importer(body, format, dryRun)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Import failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if !report.Valid {
+		c.JSON(http.StatusUnprocessableEntity, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+func newBulkReport(entity, format string, dryRun bool) *BulkReport {
+	return &BulkReport{
+		Entity:  entity,
+		Format:  format,
+		DryRun:  dryRun,
+		Valid:   true,
+		Summary: make(map[string]int),
+		Items:   []BulkItem{},
+	}
+}
+
+func (r *BulkReport) add(item BulkItem) {
+	if item.Action == ImportFailed {
+		r.Valid = false
+	}
+	r.Summary[item.Action]++
+	r.Items = append(r.Items, item)
+}
+
+func failedBulkItem(item BulkItem, err error) BulkItem {
+	item.Action = ImportFailed
+	item.Error = err.Error()
+	return item
+}
+
+// changedFields - İki entity'nin JSON alanlarını karşılaştır, farklı olan key'leri döndür
+func changedFields(before, after interface{}) []string {
+	var a, b map[string]interface{}
+	beforeJSON, _ := json.Marshal(before)
+	afterJSON, _ := json.Marshal(after)
+	json.Unmarshal(beforeJSON, &a)
+	json.Unmarshal(afterJSON, &b)
+
+	var fields []string
+	for key := range b {
+		if !reflect.DeepEqual(a[key], b[key]) {
+			fields = append(fields, key)
+		}
+	}
+	for key := range a {
+		if _, ok := b[key]; !ok {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/mailer"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// ContactHandler - İletişim formu endpoint'leri için handler
+type ContactHandler struct {
+	config      *config.Config
+	contactRepo *models.ContactRepository
+	mailer      mailer.Mailer
+}
+
+// NewContactHandler - Yeni handler oluştur
+func NewContactHandler(cfg *config.Config, redisClient *redis.Client) *ContactHandler {
+	return &ContactHandler{
+		config:      cfg,
+		contactRepo: models.NewContactRepository(redisClient),
+		mailer:      mailer.New(cfg),
+	}
+}
+
+// SubmitMessage - İletişim formu gönderimi
+// POST /api/v1/contact
+// Body: {"name": "...", "email": "...", "subject": "...", "message": "...", "website": "", "started_at": 1736421022000}
+// website: honeypot alanı (gerçek kullanıcı görmez, boş kalmalı)
+// started_at: form render zamanı (unix ms) - çok hızlı gönderimler bot kabul edilir
+func (h *ContactHandler) SubmitMessage(c *gin.Context) {
+	var request struct {
+		Name      string `json:"name" binding:"required,max=100"`
+		Email     string `json:"email" binding:"required,max=254"`
+		Subject   string `json:"subject" binding:"max=200"`
+		Message   string `json:"message" binding:"required,min=10,max=5000"`
+		Website   string `json:"website"`
+		StartedAt int64  `json:"started_at" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	request.Name = strings.TrimSpace(request.Name)
+	request.Email = strings.TrimSpace(request.Email)
+	request.Message = strings.TrimSpace(request.Message)
+
+	address, err := mail.ParseAddress(request.Email)
+	if err != nil || address.Address != request.Email || request.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Please provide a valid name and email address",
+		})
+		return
+	}
+
+	// Rate limiting kontrolü
+	clientIP := c.ClientIP()
+	window, err := time.ParseDuration(h.config.Contact.RateWindow)
+	if err != nil {
+		window = time.Hour
+	}
+	limited, err := h.contactRepo.CheckRateLimit(clientIP, h.config.Contact.RateLimit, window)
+	if err != nil {
+		fmt.Printf("Warning: Contact rate limit check failed: %v\n", err)
+	}
+	if limited {
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"error": "Too many messages. Please try again later.",
+		})
+		return
+	}
+
+	// Spam kontrolleri - bot'a ipucu vermemek için başarılı gibi cevap dön
+	if h.isSpam(request.Website, request.StartedAt) {
+		log.Printf("Contact: dropped spam submission from %s", clientIP)
+		c.JSON(http.StatusAccepted, gin.H{
+			"message": "Message received. Thank you!",
+		})
+		return
+	}
+
+	message := models.NewContactMessage(request.Name, request.Email, strings.TrimSpace(request.Subject), request.Message)
+
+	err = h.contactRepo.CreateMessage(message)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to save message",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Admin'e bildirim (opsiyonel, arka planda)
+	if h.config.Contact.NotifyEmail != "" {
+		go h.notify(*message)
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "Message received. Thank you!",
+	})
+}
+
+// GetMessages - Mesajları listele (admin)
+// GET /api/v1/contact/messages?status=unread&page=1&limit=20
+func (h *ContactHandler) GetMessages(c *gin.Context) {
+	page := 1
+	limit := 20
+
+	if pageStr := c.Query("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	status := c.Query("status")
+	if status != "" && !models.IsValidContactStatus(status) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          "Invalid status",
+			"allowed_values": models.ContactStatuses,
+		})
+		return
+	}
+
+	response, err := h.contactRepo.GetMessages(status, page, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get messages",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMessage - Tek mesaj (admin) - unread ise read olarak işaretlenir
+// GET /api/v1/contact/messages/:id
+func (h *ContactHandler) GetMessage(c *gin.Context) {
+	messageID := c.Param("id")
+
+	message, err := h.contactRepo.GetMessageByID(messageID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Message not found",
+		})
+		return
+	}
+
+	if message.Status == models.ContactUnread {
+		if updated, err := h.contactRepo.UpdateStatus(messageID, models.ContactRead); err == nil {
+			message = updated
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": message,
+	})
+}
+
+// UpdateMessageStatus - Mesaj status'unu değiştir (admin)
+// PUT /api/v1/contact/messages/:id
+// Body: {"status": "archived"}
+func (h *ContactHandler) UpdateMessageStatus(c *gin.Context) {
+	messageID := c.Param("id")
+
+	var request struct {
+		Status string `json:"status" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil || !models.IsValidContactStatus(request.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          "Invalid status",
+			"allowed_values": models.ContactStatuses,
+		})
+		return
+	}
+
+	message, err := h.contactRepo.UpdateStatus(messageID, request.Status)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Message not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Message status updated successfully",
+		"contact": message,
+	})
+}
+
+// DeleteMessage - Mesajı sil (admin)
+// DELETE /api/v1/contact/messages/:id
+func (h *ContactHandler) DeleteMessage(c *gin.Context) {
+	messageID := c.Param("id")
+
+	err := h.contactRepo.DeleteMessage(messageID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Message not found",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Helper functions
+
+// isSpam - Honeypot dolu mu veya form çok hızlı mı gönderildi?
+func (h *ContactHandler) isSpam(honeypot string, startedAt int64) bool {
+	if honeypot != "" {
+		return true
+	}
+
+	minFill, err := time.ParseDuration(h.config.Contact.MinFillTime)
+	if err != nil {
+		minFill = 3 * time.Second
+	}
+
+	// started_at saniye olarak da gönderilebilir
+	started := time.UnixMilli(startedAt)
+	if startedAt < 1e12 {
+		started = time.Unix(startedAt, 0)
+	}
+
+	elapsed := time.Since(started)
+	// Gelecekten gelen zaman damgası da sahte kabul edilir
+	return elapsed < minFill || elapsed < 0
+}
+
+// notify - Yeni mesajı admin'e mail at
+func (h *ContactHandler) notify(message models.ContactMessage) {
+	subject := "New contact message from " + message.Name
+	if message.Subject != "" {
+		subject += ": " + message.Subject
+	}
+
+	err := h.mailer.Send(mailer.Message{
+		To:      h.config.Contact.NotifyEmail,
+		Subject: subject,
+		Text: fmt.Sprintf("From: %s <%s>\nReceived: %s\n\n%s\n",
+			message.Name, message.Email, message.CreatedAt.Format("2006-01-02 15:04:05"), message.Message),
+		Headers: map[string]string{
+			"Reply-To": message.Email,
+		},
+	})
+	if err != nil {
+		log.Printf("Contact: failed to send notification for %s: %v", message.ID, err)
+	}
+}
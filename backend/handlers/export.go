@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"sort"
+	"time"
+
+	"portfolio-backend/archive"
+	"portfolio-backend/config"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gopkg.in/yaml.v3"
+)
+
+// ExportHandler - Site içeriğinin tamamını zip olarak dışa aktarır (admin)
+type ExportHandler struct {
+	blog         *BlogHandler // convertToMD için
+	blogRepo     *models.BlogRepository
+	projectsRepo *models.ProjectsRepository
+	skillsRepo   *models.SkillsRepository
+}
+
+// NewExportHandler - Yeni handler oluştur
+func NewExportHandler(cfg *config.Config, redisClient *redis.Client) *ExportHandler {
+	return &ExportHandler{
+		blog:         NewBlogHandler(cfg, redisClient),
+		blogRepo:     models.NewBlogRepository(redisClient),
+		projectsRepo: models.NewProjectsRepository(redisClient),
+		skillsRepo:   models.NewSkillsRepository(redisClient),
+	}
+}
+
+// ExportAll - Tüm site içeriğini zip olarak stream et
+// GET /api/v1/admin/export
+// posts/<slug>.md, projects/<id>.yaml, skills/<id>.yaml, uploads/, skills-upload/, blog-upload/, manifest.json
+// İçerikteki upload URL'leri archive içindeki relative path'lere çevrilir
+func (h *ExportHandler) ExportAll(c *gin.Context) {
+	// Stream başlamadan önce tüm veriyi oku - hata olursa hâlâ JSON dönebiliriz
+	posts, err := h.blogRepo.GetAllPosts()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get posts",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	projects, err := h.projectsRepo.GetAllProjects()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get projects",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	skills, err := h.skillsRepo.GetAllSkills()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get skills",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	uploads, err := archive.ListUploads()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to list upload files",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filename := fmt.Sprintf("portfolio-export-%s.zip", time.Now().Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	c.Header("Content-Type", "application/zip")
+	c.Status(http.StatusOK)
+
+	// Header'lar gönderildikten sonra hata olursa sadece log'lanabilir
+	if err := h.writeArchive(archive.NewWriter(c.Writer), posts, projects, skills, uploads); err != nil {
+		log.Printf("Export: archive stream aborted: %v", err)
+		c.Abort()
+	}
+}
+
+// writeArchive - Zip içeriğini deterministik sırayla yaz
+func (h *ExportHandler) writeArchive(w *archive.Writer, posts []models.BlogPost, projects []models.Project, skills []models.Skill, uploads map[string]string) error {
+	files, err := contentFiles(h.blog, posts, projects, skills, func(content string) string {
+		return archive.RewriteToArchive(content, "../", uploads)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := w.AddFile(file.Name, file.Data, file.ModTime); err != nil {
+			return err
+		}
+		w.Count(file.Kind)
+	}
+
+	for _, archivePath := range archive.SortedKeys(uploads) {
+		if err := w.AddFromDisk(archivePath, uploads[archivePath]); err != nil {
+			return err
+		}
+		w.Count("files")
+	}
+
+	return w.Close()
+}
+
+// contentFile - Export archive'ı ve git backup'ta yazılan tek içerik dosyası
+type contentFile struct {
+	Name    string // posts/<slug>.md, projects/<id>.yaml, skills/<id>.yaml
+	Kind    string // posts, projects, skills
+	Data    []byte
+	ModTime time.Time
+}
+
+// contentFiles - Post/proje/skill'leri deterministik sırayla dosyalara çevir
+// rewrite: içerikteki URL'leri hedefe göre dönüştürür (archive içi relative path'ler vb.)
+func contentFiles(blog *BlogHandler, posts []models.BlogPost, projects []models.Project, skills []models.Skill, rewrite func(string) string) ([]contentFile, error) {
+	sort.Slice(posts, func(i, j int) bool { return posts[i].Slug < posts[j].Slug })
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	sort.Slice(skills, func(i, j int) bool { return skills[i].ID < skills[j].ID })
+
+	used := make(map[string]bool)
+	var files []contentFile
+
+	for i := range posts {
+		post := &posts[i]
+		content, err := blog.convertToMD(post)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, contentFile{
+			Name:    uniqueName(used, path.Join(archive.PostsDir, archive.SafeName(post.Slug)), ".md"),
+			Kind:    "posts",
+			Data:    []byte(rewrite(content)),
+			ModTime: post.UpdatedAt,
+		})
+	}
+
+	for i := range projects {
+		project := &projects[i]
+		content, err := yaml.Marshal(project)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project %s: %w", project.ID, err)
+		}
+		files = append(files, contentFile{
+			Name:    uniqueName(used, path.Join(archive.ProjectsDir, archive.SafeName(project.ID)), ".yaml"),
+			Kind:    "projects",
+			Data:    []byte(rewrite(string(content))),
+			ModTime: project.UpdatedAt,
+		})
+	}
+
+	for i := range skills {
+		skill := &skills[i]
+		content, err := yaml.Marshal(skill)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal skill %s: %w", skill.ID, err)
+		}
+		files = append(files, contentFile{
+			Name:    uniqueName(used, path.Join(archive.SkillsDir, archive.SafeName(skill.ID)), ".yaml"),
+			Kind:    "skills",
+			Data:    []byte(rewrite(string(content))),
+			ModTime: skill.UpdatedAt,
+		})
+	}
+
+	return files, nil
+}
+
+// uniqueName - Aynı isme düşen entity'ler için -2, -3 ekle
+func uniqueName(used map[string]bool, base, ext string) string {
+	name := base + ext
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+	used[name] = true
+	return name
+}
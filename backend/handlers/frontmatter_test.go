@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"portfolio-backend/frontmatter"
+	"portfolio-backend/models"
+)
+
+// roundTrip - import-md → Redis → export-md akışı (post Redis'e JSON olarak yazılıp okunur)
+func roundTrip(t *testing.T, h *BlogHandler, content, filename string) string {
+	t.Helper()
+
+	post, err := h.parseMDContent(content, filename)
+	if err != nil {
+		t.Fatalf("parseMDContent(%s): %v", filename, err)
+	}
+
+	postJSON, err := post.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON(%s): %v", filename, err)
+	}
+	var stored models.BlogPost
+	if err := stored.FromJSON(postJSON); err != nil {
+		t.Fatalf("FromJSON(%s): %v", filename, err)
+	}
+
+	exported, err := h.convertToMD(&stored)
+	if err != nil {
+		t.Fatalf("convertToMD(%s): %v", filename, err)
+	}
+	return exported
+}
+
+func TestFrontmatterRoundTrip(t *testing.T) {
+	dialects, err := frontmatter.NewRegistry("")
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	h := &BlogHandler{dialects: dialects}
+
+	tests := []struct {
+		input  string
+		golden string // boşsa sadece fixed point kontrol edilir
+	}{
+		{input: "crlf.md", golden: "crlf.golden.md"},
+		{input: "draft.md", golden: "draft.golden.md"},
+		{input: "empty-fields.md", golden: "empty-fields.golden.md"},
+		{input: "extras.md", golden: "extras.golden.md"},
+		{input: "multiline.md", golden: "multiline.golden.md"},
+		{input: "quotes.md", golden: "quotes.golden.md"},
+		{input: "timestamps.md", golden: "timestamps.golden.md"},
+		{input: "unicode.md", golden: "unicode.golden.md"},
+		{input: "legacy.md"},
+		{input: "dialects/2019-03-04-hello-jekyll.md", golden: "dialects/2019-03-04-hello-jekyll.golden.md"},
+		{input: "dialects/astro-post.mdx", golden: "dialects/astro-post.golden.md"},
+		{input: "dialects/hugo-json.md", golden: "dialects/hugo-json.golden.md"},
+		{input: "dialects/hugo-toml.md", golden: "dialects/hugo-toml.golden.md"},
+		{input: "dialects/hugo-yaml.md", golden: "dialects/hugo-yaml.golden.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			input := readTestdata(t, tt.input)
+
+			// Dialect tespiti için dosyanın gerçek adı gönderilir
+			exported := roundTrip(t, h, input, filepath.Base(tt.input))
+
+			if tt.golden != "" {
+				if want := readTestdata(t, tt.golden); exported != want {
+					t.Errorf("export of %s does not match %s\ngot:\n%s\nwant:\n%s", tt.input, tt.golden, exported, want)
+				}
+			}
+
+			// Export edilen dosya tekrar import edildiğinde aynı çıktıyı vermeli
+			name := strings.TrimSuffix(filepath.Base(tt.input), filepath.Ext(tt.input)) + ".md"
+			if again := roundTrip(t, h, exported, name); again != exported {
+				t.Errorf("export of %s is not a fixed point\nfirst:\n%s\nsecond:\n%s", tt.input, exported, again)
+			}
+		})
+	}
+}
+
+func readTestdata(t *testing.T, name string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "frontmatter", name))
+	if err != nil {
+		t.Fatalf("read %s: %v", name, err)
+	}
+	return string(data)
+}
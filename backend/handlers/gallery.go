@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// galleryDir - Galeri dosyaları uploads altında ayrı klasörde
+// cleanupOldProjectImages'ın "{id}-*" glob'u bu dosyalara dokunmaz
+const galleryDir = "gallery"
+
+// allowedImageExts - Galeriye yüklenebilecek uzantılar (UploadProjectImage ile aynı)
+var allowedImageExts = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".svg":  true,
+	".webp": true,
+}
+
+// UploadGalleryImage - Proje galerisine görsel ekle (sona eklenir)
+// POST /api/v1/upload/project/:id/gallery
+// Form: file, alt, caption, cover=true (Image alanını da bu görsel yapar)
+func (h *UploadHandler) UploadGalleryImage(c *gin.Context) {
+	project, ok := h.galleryProject(c)
+	if !ok {
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "No file provided",
+		})
+		return
+	}
+	defer file.Close()
+
+	// Dosya boyutu kontrolü (10MB)
+	if header.Size > 10*1024*1024 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "File size too large. Maximum size is 10MB",
+		})
+		return
+	}
+
+	ext := strings.ToLower(filepath.Ext(header.Filename))
+	if !allowedImageExts[ext] {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid file type. Only images are allowed",
+		})
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to read file",
+		})
+		return
+	}
+
+	width, height := imageDimensions(data, ext)
+	galleryImage := models.NewProjectImage("", c.PostForm("alt"), c.PostForm("caption"), width, height)
+
+	// uploads/gallery/{safe-id}/{image-id}.{ext}
+	dir := filepath.Join(h.uploadDir, galleryDir, safeProjectFileID(project.ID))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to create gallery directory",
+		})
+		return
+	}
+
+	filename := galleryImage.ID + ext
+	if err := os.WriteFile(filepath.Join(dir, filename), data, 0644); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to save file",
+		})
+		return
+	}
+	galleryImage.URL = fmt.Sprintf("/uploads/%s/%s/%s", galleryDir, safeProjectFileID(project.ID), filename)
+
+	project.Gallery = append(project.Gallery, galleryImage)
+	// Cover yoksa ilk galeri görseli cover olur
+	if project.Image == "" || c.PostForm("cover") == "true" {
+		project.Image = galleryImage.URL
+	}
+
+	if !h.saveGalleryProject(c, project) {
+		os.Remove(filepath.Join(dir, filename))
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Gallery image uploaded successfully",
+		"image":   galleryImage,
+		"project": project,
+	})
+}
+
+// UpdateGalleryImage - Alt text ve caption güncelle
+// PUT /api/v1/upload/project/:id/gallery/:imageId
+func (h *UploadHandler) UpdateGalleryImage(c *gin.Context) {
+	project, ok := h.galleryProject(c)
+	if !ok {
+		return
+	}
+
+	var request struct {
+		Alt     *string `json:"alt"`
+		Caption *string `json:"caption"`
+		Cover   bool    `json:"cover"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	galleryImage, err := project.GalleryImage(c.Param("imageId"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Gallery image not found",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if request.Alt != nil {
+		galleryImage.Alt = *request.Alt
+	}
+	if request.Caption != nil {
+		galleryImage.Caption = *request.Caption
+	}
+	if request.Cover {
+		project.Image = galleryImage.URL
+	}
+
+	if !h.saveGalleryProject(c, project) {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Gallery image updated successfully",
+		"image":   galleryImage,
+		"project": project,
+	})
+}
+
+// ReorderGallery - Galeri sırasını kaydet
+// PUT /api/v1/upload/project/:id/gallery/order
+// Body: {"ids": ["a1b2c3", ...]} - tüm görseller, istenen sırada
+func (h *UploadHandler) ReorderGallery(c *gin.Context) {
+	project, ok := h.galleryProject(c)
+	if !ok {
+		return
+	}
+
+	var request struct {
+		IDs []string `json:"ids" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := project.ReorderGallery(request.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid gallery order",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if !h.saveGalleryProject(c, project) {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Gallery order updated successfully",
+		"gallery": project.Gallery,
+	})
+}
+
+// DeleteGalleryImage - Görseli galeriden ve diskten sil
+// DELETE /api/v1/upload/project/:id/gallery/:imageId
+func (h *UploadHandler) DeleteGalleryImage(c *gin.Context) {
+	project, ok := h.galleryProject(c)
+	if !ok {
+		return
+	}
+
+	galleryImage, err := project.RemoveGalleryImage(c.Param("imageId"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Gallery image not found",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if !h.saveGalleryProject(c, project) {
+		return
+	}
+
+	// Sadece galeri klasöründeki dosyalar silinir (URL dışarıdan import edilmiş olabilir)
+	prefix := fmt.Sprintf("/uploads/%s/", galleryDir)
+	if strings.HasPrefix(galleryImage.URL, prefix) && !strings.Contains(galleryImage.URL, "..") {
+		path := filepath.Join(h.uploadDir, filepath.FromSlash(strings.TrimPrefix(galleryImage.URL, "/uploads/")))
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			fmt.Printf("Warning: Failed to delete gallery file %s: %v\n", path, err)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Gallery image deleted successfully",
+		"image":   galleryImage,
+		"project": project,
+	})
+}
+
+// galleryProject - :id'deki projeyi al, yoksa 404 yaz
+func (h *UploadHandler) galleryProject(c *gin.Context) (*models.Project, bool) {
+	project, err := h.projectsRepo.GetProjectByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Project not found",
+		})
+		return nil, false
+	}
+	return project, true
+}
+
+// saveGalleryProject - Projeyi kaydet ve event yayınla, hata varsa 500 yaz
+func (h *UploadHandler) saveGalleryProject(c *gin.Context, project *models.Project) bool {
+	if err := h.projectsRepo.UpdateProject(project); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update project",
+			"details": err.Error(),
+		})
+		return false
+	}
+
+	events.Publish(events.ProjectUpdated, project)
+	return true
+}
+
+// imageDimensions - Görsel boyutları; okunamazsa 0, 0
+// PNG/JPEG/GIF standart kütüphane ile, WebP ve SVG header'dan okunur
+func imageDimensions(data []byte, ext string) (int, int) {
+	switch ext {
+	case ".svg":
+		return svgDimensions(data)
+	case ".webp":
+		return webpDimensions(data)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0
+	}
+	return config.Width, config.Height
+}
+
+// webpDimensions - RIFF header'ındaki VP8/VP8L/VP8X chunk'ından boyut
+func webpDimensions(data []byte) (int, int) {
+	if len(data) < 30 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0
+	}
+
+	chunk := data[20:]
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// Keyframe: 3 byte frame tag + 3 byte start code, sonra 14 bit genişlik/yükseklik
+		if len(chunk) < 10 {
+			return 0, 0
+		}
+		return int(binary.LittleEndian.Uint16(chunk[6:8]) & 0x3fff), int(binary.LittleEndian.Uint16(chunk[8:10]) & 0x3fff)
+	case "VP8L":
+		// 1 byte signature, sonra 14 bit (genişlik-1) ve 14 bit (yükseklik-1)
+		if len(chunk) < 5 || chunk[0] != 0x2f {
+			return 0, 0
+		}
+		bits := binary.LittleEndian.Uint32(chunk[1:5])
+		return int(bits&0x3fff) + 1, int((bits>>14)&0x3fff) + 1
+	case "VP8X":
+		// 4 byte flags, sonra 24 bit (genişlik-1) ve 24 bit (yükseklik-1)
+		if len(chunk) < 10 {
+			return 0, 0
+		}
+		width := int(chunk[4]) | int(chunk[5])<<8 | int(chunk[6])<<16
+		height := int(chunk[7]) | int(chunk[8])<<8 | int(chunk[9])<<16
+		return width + 1, height + 1
+	}
+	return 0, 0
+}
+
+// svgDimensions - Root <svg> elementinin width/height'ı, yoksa viewBox
+func svgDimensions(data []byte) (int, int) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return 0, 0
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "svg" {
+			return 0, 0
+		}
+
+		var width, height, viewBox string
+		for _, attr := range start.Attr {
+			switch attr.Name.Local {
+			case "width":
+				width = attr.Value
+			case "height":
+				height = attr.Value
+			case "viewBox":
+				viewBox = attr.Value
+			}
+		}
+
+		w, h := svgLength(width), svgLength(height)
+		if w > 0 && h > 0 {
+			return w, h
+		}
+		if fields := strings.Fields(strings.ReplaceAll(viewBox, ",", " ")); len(fields) == 4 {
+			return svgLength(fields[2]), svgLength(fields[3])
+		}
+		return 0, 0
+	}
+}
+
+// svgLength - "120", "120px", "120.5" -> 120; yüzde ve diğer birimler 0
+func svgLength(value string) int {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "px")
+	length, err := strconv.ParseFloat(value, 64)
+	if err != nil || length <= 0 {
+		return 0
+	}
+	return int(length + 0.5)
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// webpHeader - Sadece boyut okunan kısmı dolu bir RIFF/WEBP header'ı
+func webpHeader(chunk string, payload []byte) []byte {
+	data := append([]byte("RIFF\x00\x00\x00\x00WEBP"+chunk+"\x00\x00\x00\x00"), payload...)
+	for len(data) < 30 {
+		data = append(data, 0)
+	}
+	return data
+}
+
+func TestImageDimensions(t *testing.T) {
+	vp8 := make([]byte, 10)
+	copy(vp8, "\x9d\x01\x2a\x9d\x01\x2a")
+	binary.LittleEndian.PutUint16(vp8[6:], 640)
+	binary.LittleEndian.PutUint16(vp8[8:], 480)
+
+	vp8l := []byte{0x2f, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(vp8l[1:], uint32(99)|uint32(49)<<14)
+
+	vp8x := []byte{0, 0, 0, 0, 0x1f, 0x03, 0, 0xdf, 0x01, 0} // 800-1, 480-1
+
+	tests := []struct {
+		name          string
+		ext           string
+		data          []byte
+		width, height int
+	}{
+		{"png", ".png", encodePNG(t, 3, 2), 3, 2},
+		{"webp lossy", ".webp", webpHeader("VP8 ", vp8), 640, 480},
+		{"webp lossless", ".webp", webpHeader("VP8L", vp8l), 100, 50},
+		{"webp extended", ".webp", webpHeader("VP8X", vp8x), 800, 480},
+		{"webp truncated", ".webp", []byte("RIFF\x00\x00\x00\x00WEBP"), 0, 0},
+		{"svg size", ".svg", []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="120px" height="80.4"/>`), 120, 80},
+		{"svg viewBox", ".svg", []byte(`<?xml version="1.0"?><svg viewBox="0 0 24 16"></svg>`), 24, 16},
+		{"svg percent", ".svg", []byte(`<svg width="100%" height="100%"/>`), 0, 0},
+		{"not an svg", ".svg", []byte(`<html/>`), 0, 0},
+		{"broken png", ".png", []byte("not a png"), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height := imageDimensions(tt.data, tt.ext)
+			if width != tt.width || height != tt.height {
+				t.Errorf("imageDimensions = %dx%d, want %dx%d", width, height, tt.width, tt.height)
+			}
+		})
+	}
+}
+
+func TestGalleryUploadAndDelete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Chdir(t.TempDir())
+	_, client := newTestRedis(t)
+
+	project := models.NewProject("My Site", "", "", "", "Live", nil)
+	if err := models.NewProjectsRepository(client).CreateProject(project); err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+
+	h := NewUploadHandler(client)
+	router := gin.New()
+	router.POST("/project/:id/gallery", h.UploadGalleryImage)
+	router.DELETE("/project/:id/gallery/:imageId", h.DeleteGalleryImage)
+
+	upload := func(filename string, data []byte, fields map[string]string) (*httptest.ResponseRecorder, models.ProjectImage) {
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		fw, _ := mw.CreateFormFile("file", filename)
+		fw.Write(data)
+		for key, value := range fields {
+			mw.WriteField(key, value)
+		}
+		mw.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/project/"+url.PathEscape(project.ID)+"/gallery", &body)
+		req.Header.Set("Content-Type", mw.FormDataContentType())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Image models.ProjectImage `json:"image"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return w, response.Image
+	}
+
+	tests := []struct {
+		name      string
+		filename  string
+		data      []byte
+		fields    map[string]string
+		status    int
+		width     int
+		height    int
+		wantCover bool
+	}{
+		{"first image becomes the cover", "a.png", encodePNG(t, 4, 3), map[string]string{"alt": "Home page"}, http.StatusCreated, 4, 3, true},
+		{"second image keeps the cover", "b.svg", []byte(`<svg width="10" height="20"/>`), nil, http.StatusCreated, 10, 20, false},
+		{"explicit cover", "c.PNG", encodePNG(t, 1, 1), map[string]string{"cover": "true"}, http.StatusCreated, 1, 1, true},
+		{"rejects other types", "evil.html", []byte("<script>"), nil, http.StatusBadRequest, 0, 0, false},
+	}
+
+	var uploaded []models.ProjectImage
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, galleryImage := upload(tt.filename, tt.data, tt.fields)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if tt.status != http.StatusCreated {
+				return
+			}
+			uploaded = append(uploaded, galleryImage)
+
+			// uploads/gallery/<safe-id>/<image-id>.<ext>
+			wantPrefix := "/uploads/gallery/project-my-site/" + galleryImage.ID + "."
+			if !strings.HasPrefix(galleryImage.URL, wantPrefix) {
+				t.Errorf("URL = %s, want prefix %s", galleryImage.URL, wantPrefix)
+			}
+			data, err := os.ReadFile("." + galleryImage.URL)
+			if err != nil || !bytes.Equal(data, tt.data) {
+				t.Errorf("file %s not written: %v", galleryImage.URL, err)
+			}
+			if galleryImage.Width != tt.width || galleryImage.Height != tt.height || galleryImage.Alt != tt.fields["alt"] {
+				t.Errorf("image = %+v, want %dx%d alt %q", galleryImage, tt.width, tt.height, tt.fields["alt"])
+			}
+
+			saved, _ := models.NewProjectsRepository(client).GetProjectByID(project.ID)
+			if (saved.Image == galleryImage.URL) != tt.wantCover {
+				t.Errorf("cover = %s, want this image: %v", saved.Image, tt.wantCover)
+			}
+		})
+	}
+
+	// Sil: dosya ve galeri kaydı gider, diğer görseller kalır
+	removed := uploaded[1]
+	req := httptest.NewRequest(http.MethodDelete, "/project/"+url.PathEscape(project.ID)+"/gallery/"+removed.ID, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("delete status = %d: %s", w.Code, w.Body.String())
+	}
+	if _, err := os.Stat("." + removed.URL); !os.IsNotExist(err) {
+		t.Errorf("%s still on disk: %v", removed.URL, err)
+	}
+	remaining, _ := filepath.Glob(filepath.Join("uploads", "gallery", "project-my-site", "*"))
+	if len(remaining) != 2 {
+		t.Errorf("gallery files = %v, want 2", remaining)
+	}
+
+	saved, _ := models.NewProjectsRepository(client).GetProjectByID(project.ID)
+	if len(saved.Gallery) != 2 || saved.Gallery[0].ID != uploaded[0].ID || saved.Gallery[1].ID != uploaded[2].ID {
+		t.Errorf("gallery after delete = %+v", saved.Gallery)
+	}
+
+	// Olmayan görsel
+	req = httptest.NewRequest(http.MethodDelete, "/project/"+url.PathEscape(project.ID)+"/gallery/"+removed.ID, nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("second delete status = %d, want 404", w.Code)
+	}
+}
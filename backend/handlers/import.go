@@ -0,0 +1,426 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"portfolio-backend/archive"
+	"portfolio-backend/config"
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gopkg.in/yaml.v3"
+)
+
+// Import item action'ları
+const (
+	ImportCreated     = "created"
+	ImportOverwritten = "overwritten"
+	ImportMerged      = "merged"
+	ImportSkipped     = "skipped"
+	ImportUnchanged   = "unchanged"
+	ImportIgnored     = "ignored"
+	ImportFailed      = "failed"
+)
+
+// ImportItem - Archive'daki tek dosyanın import sonucu
+type ImportItem struct {
+	Type   string `json:"type"` // post, project, skill, file
+	Path   string `json:"path"`
+	ID     string `json:"id,omitempty"`
+	Action string `json:"action"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ImportModes - Entity tipi başına çakışma modu
+type ImportModes struct {
+	Posts    string `json:"posts"`
+	Projects string `json:"projects"`
+	Skills   string `json:"skills"`
+	Files    string `json:"files"`
+}
+
+// ImportHandler - Zip archive'dan site içeriği import eder (admin)
+type ImportHandler struct {
+	blog         *BlogHandler // parseMDContent ve processImagePaths için
+	blogRepo     *models.BlogRepository
+	projectsRepo *models.ProjectsRepository
+	skillsRepo   *models.SkillsRepository
+}
+
+// NewImportHandler - Yeni handler oluştur
+func NewImportHandler(cfg *config.Config, redisClient *redis.Client) *ImportHandler {
+	return &ImportHandler{
+		blog:         NewBlogHandler(cfg, redisClient),
+		blogRepo:     models.NewBlogRepository(redisClient),
+		projectsRepo: models.NewProjectsRepository(redisClient),
+		skillsRepo:   models.NewSkillsRepository(redisClient),
+	}
+}
+
+// ImportAll - Zip archive import et (export formatı veya elle hazırlanmış zip)
+// POST /api/v1/admin/import?mode=skip|overwrite|merge
+// Form: file=<archive.zip>
+// Entity bazında mod: posts_mode, projects_mode, skills_mode, files_mode (varsayılan: mode, o da yoksa skip)
+// posts: *.md, projects/*.yaml, skills/*.yaml, resimler: uploads/, skills-upload/, blog-upload/ (diğerleri blog-upload'a)
+func (h *ImportHandler) ImportAll(c *gin.Context) {
+	modes, err := parseImportModes(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          err.Error(),
+			"allowed_values": []string{archive.ModeSkip, archive.ModeOverwrite, archive.ModeMerge},
+		})
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "No archive uploaded (form field: file)",
+		})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to open uploaded archive",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	zr, err := zip.NewReader(file, header.Size)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid zip archive",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	manifest, err := archive.ReadManifest(zr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid archive manifest",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	items := h.importArchive(zr, manifest, modes)
+
+	summary := make(map[string]int)
+	for _, item := range items {
+		summary[item.Action]++
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Archive import completed",
+		"modes":   modes,
+		"summary": summary,
+		"items":   items,
+	})
+}
+
+// importArchive - Önce resimler (referans hedefleri belli olsun), sonra post/proje/skill'ler
+func (h *ImportHandler) importArchive(zr *zip.Reader, manifest *archive.Manifest, modes ImportModes) []ImportItem {
+	var images, posts, projects, skills []*zip.File
+	var items []ImportItem
+
+	for _, f := range zr.File {
+		name := archive.CleanPath(f.Name)
+		if f.FileInfo().IsDir() || name == "" || name == archive.ManifestName {
+			continue
+		}
+
+		dir := strings.SplitN(name, "/", 2)[0]
+		ext := strings.ToLower(path.Ext(name))
+
+		switch {
+		case archive.IsImage(name):
+			images = append(images, f)
+		case ext == ".md" || ext == ".mdx":
+			posts = append(posts, f)
+		case (ext == ".yaml" || ext == ".yml") && dir == archive.ProjectsDir:
+			projects = append(projects, f)
+		case (ext == ".yaml" || ext == ".yml") && dir == archive.SkillsDir:
+			skills = append(skills, f)
+		default:
+			items = append(items, ImportItem{Type: "file", Path: name, Action: ImportIgnored, Error: "unsupported file type or location"})
+		}
+	}
+
+	// archive path -> public URL
+	targets := make(map[string]string)
+	for _, f := range images {
+		item, url := h.importImage(f, manifest, modes.Files)
+		if url != "" {
+			targets[item.Path] = url
+		}
+		items = append(items, item)
+	}
+
+	for _, f := range posts {
+		items = append(items, h.importPost(f, manifest, modes.Posts, targets))
+	}
+	for _, f := range projects {
+		items = append(items, h.importProject(f, manifest, modes.Projects, targets))
+	}
+	for _, f := range skills {
+		items = append(items, h.importSkill(f, manifest, modes.Skills, targets))
+	}
+
+	return items
+}
+
+// importImage - Resmi ilgili upload klasörüne yaz
+// Dönen public URL, içerikteki referansları çözmek için kullanılır (hata durumunda boş)
+func (h *ImportHandler) importImage(f *zip.File, manifest *archive.Manifest, mode string) (ImportItem, string) {
+	name := archive.CleanPath(f.Name)
+	item := ImportItem{Type: "file", Path: name}
+
+	// Bilinen upload klasörlerinde alt klasör yapısı korunur (uploads/gallery/<id>/x.png),
+	// diğer resimler blog-upload'a düz olarak yazılır
+	archiveDir, rel, nested := strings.Cut(name, "/")
+	diskDir, ok := archive.UploadDirs[archiveDir]
+	if !ok || !nested {
+		archiveDir = "blog-upload"
+		diskDir = archive.UploadDirs[archiveDir]
+		rel = path.Base(name)
+	}
+
+	diskPath := filepath.Join(diskDir, filepath.FromSlash(rel))
+	publicURL := "/" + archiveDir + "/" + rel
+	item.ID = publicURL
+
+	data, err := readVerified(f, manifest, name)
+	if err != nil {
+		return failedItem(item, err), ""
+	}
+
+	existing, err := os.ReadFile(diskPath)
+	switch {
+	case err == nil && bytes.Equal(existing, data):
+		item.Action = ImportUnchanged
+		return item, publicURL
+	case err == nil && mode == archive.ModeSkip:
+		item.Action = ImportSkipped
+		return item, publicURL
+	case err == nil:
+		item.Action = ImportOverwritten
+	default:
+		item.Action = ImportCreated
+	}
+
+	if err := os.MkdirAll(filepath.Dir(diskPath), 0755); err != nil {
+		return failedItem(item, err), ""
+	}
+	if err := os.WriteFile(diskPath, data, 0644); err != nil {
+		return failedItem(item, err), ""
+	}
+
+	return item, publicURL
+}
+
+// importPost - MD dosyasını BlogPost olarak import et
+func (h *ImportHandler) importPost(f *zip.File, manifest *archive.Manifest, mode string, targets map[string]string) ImportItem {
+	name := archive.CleanPath(f.Name)
+	item := ImportItem{Type: "post", Path: name}
+
+	data, err := readVerified(f, manifest, name)
+	if err != nil {
+		return failedItem(item, err)
+	}
+
+	// Archive içi referanslar -> public URL, kalan relative path'ler parseMDContent içinde processImagePaths ile
+	content := archive.ResolveRefs(string(data), path.Dir(name), targets)
+
+	post, err := h.blog.parseMDContent(content, path.Base(name))
+	if err != nil {
+		return failedItem(item, err)
+	}
+	item.ID = post.ID
+
+	existing, err := h.blogRepo.GetPostByID(post.ID)
+	if err != nil {
+		if err := h.blogRepo.CreatePost(post); err != nil {
+			return failedItem(item, err)
+		}
+		h.blog.publishImported(post)
+		item.Action = ImportCreated
+		return item
+	}
+
+	switch mode {
+	case archive.ModeSkip:
+		item.Action = ImportSkipped
+		return item
+	case archive.ModeMerge:
+		merged := *existing
+		archive.MergeNonZero(&merged, post)
+		post = &merged
+		item.Action = ImportMerged
+	default:
+		item.Action = ImportOverwritten
+	}
+
+	if err := h.blog.replacePost(existing, post); err != nil {
+		return failedItem(item, err)
+	}
+
+	return item
+}
+
+// importProject - YAML dosyasını Project olarak import et
+func (h *ImportHandler) importProject(f *zip.File, manifest *archive.Manifest, mode string, targets map[string]string) ImportItem {
+	name := archive.CleanPath(f.Name)
+	item := ImportItem{Type: "project", Path: name}
+
+	data, err := readVerified(f, manifest, name)
+	if err != nil {
+		return failedItem(item, err)
+	}
+
+	var project models.Project
+	if err := yaml.Unmarshal([]byte(archive.ResolveRefs(string(data), path.Dir(name), targets)), &project); err != nil {
+		return failedItem(item, fmt.Errorf("yaml parsing failed: %w", err))
+	}
+	if project.Title == "" {
+		return failedItem(item, fmt.Errorf("title is required"))
+	}
+	if project.ID == "" {
+		project.ID = models.NewProject(project.Title, "", "", "", "", nil).ID
+	}
+	item.ID = project.ID
+
+	existing, err := h.projectsRepo.GetProjectByID(project.ID)
+	if err != nil {
+		if err := h.projectsRepo.CreateProject(&project); err != nil {
+			return failedItem(item, err)
+		}
+		events.Publish(events.ProjectCreated, &project)
+		item.Action = ImportCreated
+		return item
+	}
+
+	switch mode {
+	case archive.ModeSkip:
+		item.Action = ImportSkipped
+		return item
+	case archive.ModeMerge:
+		archive.MergeNonZero(existing, &project)
+		project = *existing
+		item.Action = ImportMerged
+	default:
+		item.Action = ImportOverwritten
+	}
+
+	if err := h.projectsRepo.UpdateProject(&project); err != nil {
+		return failedItem(item, err)
+	}
+
+	events.Publish(events.ProjectUpdated, &project)
+	return item
+}
+
+// importSkill - YAML dosyasını Skill olarak import et
+func (h *ImportHandler) importSkill(f *zip.File, manifest *archive.Manifest, mode string, targets map[string]string) ImportItem {
+	name := archive.CleanPath(f.Name)
+	item := ImportItem{Type: "skill", Path: name}
+
+	data, err := readVerified(f, manifest, name)
+	if err != nil {
+		return failedItem(item, err)
+	}
+
+	var skill models.Skill
+	if err := yaml.Unmarshal([]byte(archive.ResolveRefs(string(data), path.Dir(name), targets)), &skill); err != nil {
+		return failedItem(item, fmt.Errorf("yaml parsing failed: %w", err))
+	}
+	if skill.Category == "" || skill.Skill == "" {
+		return failedItem(item, fmt.Errorf("category and skill are required"))
+	}
+	if skill.ID == "" {
+		skill.ID = models.NewSkill(skill.Category, skill.Skill, "").ID
+	}
+	item.ID = skill.ID
+
+	existing, err := h.skillsRepo.GetSkillByID(skill.ID)
+	if err != nil {
+		if err := h.skillsRepo.CreateSkill(&skill); err != nil {
+			return failedItem(item, err)
+		}
+		events.Publish(events.SkillCreated, &skill)
+		item.Action = ImportCreated
+		return item
+	}
+
+	switch mode {
+	case archive.ModeSkip:
+		item.Action = ImportSkipped
+		return item
+	case archive.ModeMerge:
+		archive.MergeNonZero(existing, &skill)
+		skill = *existing
+		item.Action = ImportMerged
+	default:
+		item.Action = ImportOverwritten
+	}
+
+	if err := h.skillsRepo.UpdateSkill(&skill); err != nil {
+		return failedItem(item, err)
+	}
+
+	events.Publish(events.SkillUpdated, &skill)
+	return item
+}
+
+// Helper functions
+
+// parseImportModes - mode ve <entity>_mode query parametreleri
+func parseImportModes(c *gin.Context) (ImportModes, error) {
+	fallback := c.DefaultQuery("mode", archive.ModeSkip)
+
+	modes := ImportModes{
+		Posts:    c.DefaultQuery("posts_mode", fallback),
+		Projects: c.DefaultQuery("projects_mode", fallback),
+		Skills:   c.DefaultQuery("skills_mode", fallback),
+		Files:    c.DefaultQuery("files_mode", fallback),
+	}
+
+	for _, mode := range []string{modes.Posts, modes.Projects, modes.Skills, modes.Files} {
+		if !archive.IsValidMode(mode) {
+			return modes, fmt.Errorf("invalid import mode: %s", mode)
+		}
+	}
+
+	return modes, nil
+}
+
+// readVerified - Entry'yi oku ve manifest checksum'ı ile karşılaştır
+func readVerified(f *zip.File, manifest *archive.Manifest, name string) ([]byte, error) {
+	data, err := archive.ReadEntry(f)
+	if err != nil {
+		return nil, err
+	}
+	if err := manifest.VerifyChecksum(name, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func failedItem(item ImportItem, err error) ImportItem {
+	item.Action = ImportFailed
+	item.Error = err.Error()
+	return item
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"portfolio-backend/archive"
+	"portfolio-backend/config"
+)
+
+func TestArchiveImportRejectsTraversal(t *testing.T) {
+	root := t.TempDir()
+	work := filepath.Join(root, "work")
+	if err := os.Mkdir(work, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(work)
+	_, client := newTestRedis(t)
+
+	entries := []string{
+		"../escape.png",
+		"../../escape.png",
+		"uploads/../../escape.png",
+		"blog-upload/../../escape.png",
+		"..\\escape.png",
+		"posts/../../escape.md",
+		"/abs.png", // Kök kaldırılır, blog-upload/abs.png olur
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range entries {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte("payload"))
+	}
+	zw.Close()
+
+	h := NewImportHandler(&config.Config{}, client)
+	items := h.importArchive(readZip(t, buf.Bytes()), nil, ImportModes{Files: archive.ModeOverwrite, Posts: archive.ModeOverwrite})
+
+	if len(items) != 1 || items[0].Path != "abs.png" || items[0].ID != "/blog-upload/abs.png" || items[0].Action != ImportCreated {
+		t.Errorf("items = %+v, want only abs.png created as /blog-upload/abs.png", items)
+	}
+
+	// Çalışma klasörünün dışına hiçbir şey yazılmamalı
+	outside, err := filepath.Glob(filepath.Join(root, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outside) != 1 || outside[0] != work {
+		t.Errorf("files outside the working directory: %v", outside)
+	}
+	if _, err := os.Stat(filepath.Join("blog-upload", "abs.png")); err != nil {
+		t.Errorf("abs.png was not imported: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// MigrationsHandler - Mevcut veriyi yeni modele taşıyan tek seferlik admin işlemleri
+// Hepsi tekrar çalıştırılabilir ve ?dry_run=true ile sadece rapor döner
+type MigrationsHandler struct {
+	projectsRepo *models.ProjectsRepository
+	statusesRepo *models.ProjectStatusesRepository
+}
+
+// NewMigrationsHandler - Yeni handler oluştur
+func NewMigrationsHandler(redisClient *redis.Client) *MigrationsHandler {
+	return &MigrationsHandler{
+		projectsRepo: models.NewProjectsRepository(redisClient),
+		statusesRepo: models.NewProjectStatusesRepository(redisClient),
+	}
+}
+
+// LinkProjectTools - Proje tool'larını isim/icon ile skill'lere bağla
+// POST /api/v1/admin/migrations/project-tools?dry_run=true
+// Eşleşmeyen tool'lar değişmeden kalır ve "unmatched" listesinde döner
+func (h *MigrationsHandler) LinkProjectTools(c *gin.Context) {
+	report, err := h.projectsRepo.LinkAllTools(isDryRun(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to link project tools",
+			"details": err.Error(),
+			"report":  report,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// AssignProjectSlugs - Slug'ı olmayan projelere title'dan slug ata
+// POST /api/v1/admin/migrations/project-slugs?dry_run=true
+func (h *MigrationsHandler) AssignProjectSlugs(c *gin.Context) {
+	report, err := h.projectsRepo.AssignMissingSlugs(isDryRun(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to assign project slugs",
+			"details": err.Error(),
+			"report":  report,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// NormalizeProjectStatuses - Proje status'larını tanımlı status key'lerine çevir ("live" -> "Live")
+// POST /api/v1/admin/migrations/project-statuses?dry_run=true
+// Eşleşmeyen status'lar "unknown" listesinde döner, önce tanımlanıp tekrar çalıştırılmalı
+func (h *MigrationsHandler) NormalizeProjectStatuses(c *gin.Context) {
+	statuses, err := h.statusesRepo.GetAllStatuses()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get project statuses",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	report, err := h.projectsRepo.NormalizeStatuses(statuses, isDryRun(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to normalize project statuses",
+			"details": err.Error(),
+			"report":  report,
+		})
+		return
+	}
+
+	if !report.DryRun && len(report.Changed) > 0 {
+		events.Publish(events.ProjectStatusesUpdated, statuses)
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"portfolio-backend/config"
+	"portfolio-backend/models"
+	"portfolio-backend/newsletter"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// NewsletterHandler - Newsletter abonelik endpoint'leri için handler
+type NewsletterHandler struct {
+	newsletter *newsletter.Service
+}
+
+// NewNewsletterHandler - Yeni handler oluştur
+func NewNewsletterHandler(cfg *config.Config, redisClient *redis.Client) *NewsletterHandler {
+	return &NewsletterHandler{
+		newsletter: newsletter.NewService(cfg, redisClient),
+	}
+}
+
+// Subscribe - Abonelik isteği (confirm maili gönderir)
+// POST /api/v1/newsletter/subscribe
+// Body: {"email": "jane@example.com"}
+func (h *NewsletterHandler) Subscribe(c *gin.Context) {
+	var request struct {
+		Email string `json:"email" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	err := h.newsletter.Subscribe(request.Email)
+	if errors.Is(err, newsletter.ErrInvalidEmail) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid email address",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to subscribe",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Adresin zaten kayıtlı olup olmadığını sızdırmamak için her zaman aynı cevap
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "Please check your inbox to confirm your subscription",
+	})
+}
+
+// Confirm - Double opt-in onayı
+// GET /api/v1/newsletter/confirm?token=...
+func (h *NewsletterHandler) Confirm(c *gin.Context) {
+	subscriber, err := h.newsletter.Confirm(c.Query("token"))
+	if err != nil {
+		h.tokenError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Subscription confirmed",
+		"email":   subscriber.Email,
+		"status":  subscriber.Status,
+	})
+}
+
+// Unsubscribe - Abonelikten çık
+// GET/POST /api/v1/newsletter/unsubscribe?token=...
+// POST, mail client'ların one-click unsubscribe (RFC 8058) isteği için
+func (h *NewsletterHandler) Unsubscribe(c *gin.Context) {
+	subscriber, err := h.newsletter.Unsubscribe(c.Query("token"))
+	if err != nil {
+		h.tokenError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "You have been unsubscribed",
+		"email":   subscriber.Email,
+		"status":  subscriber.Status,
+	})
+}
+
+// GetSubscribers - Aboneleri listele (admin)
+// GET /api/v1/newsletter/subscribers?status=confirmed
+func (h *NewsletterHandler) GetSubscribers(c *gin.Context) {
+	status := c.Query("status")
+	switch status {
+	case "", models.SubscriberPending, models.SubscriberConfirmed, models.SubscriberUnsubscribed:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid status. Allowed: pending, confirmed, unsubscribed",
+		})
+		return
+	}
+
+	subscribers, err := h.newsletter.Repository().GetSubscribers(status)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get subscribers",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":       len(subscribers),
+		"subscribers": subscribers,
+	})
+}
+
+// DeleteSubscriber - Aboneyi tamamen sil (admin)
+// DELETE /api/v1/newsletter/subscribers/:email
+func (h *NewsletterHandler) DeleteSubscriber(c *gin.Context) {
+	email := c.Param("email")
+
+	err := h.newsletter.Repository().DeleteSubscriber(email)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Subscriber not found",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// tokenError - Token hatalarını HTTP response'a çevir
+func (h *NewsletterHandler) tokenError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, newsletter.ErrExpiredToken):
+		c.JSON(http.StatusGone, gin.H{
+			"error": "Link has expired, please subscribe again",
+		})
+	case errors.Is(err, newsletter.ErrInvalidToken):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid link",
+		})
+	default:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Subscriber not found",
+		})
+	}
+}
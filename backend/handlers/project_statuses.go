@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetProjectStatusDefinitions - Tanımlı status'lar (key, label, renk, sıra)
+// GET /api/v1/project-statuses
+func (h *ProjectsHandler) GetProjectStatusDefinitions(c *gin.Context) {
+	statuses, err := h.statusesRepo.GetAllStatuses()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get project statuses",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":    len(statuses),
+		"statuses": statuses,
+	})
+}
+
+// CreateProjectStatus - Yeni status tanımla
+// POST /api/v1/project-statuses
+// Body: {"key": "Archived", "label": "Arşiv", "color": "#94a3b8", "order": 4}
+func (h *ProjectsHandler) CreateProjectStatus(c *gin.Context) {
+	var request struct {
+		Key   string `json:"key" binding:"required"`
+		Label string `json:"label"`
+		Color string `json:"color"`
+		Order int    `json:"order"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	status := models.NewProjectStatus(request.Key, request.Label, request.Color, request.Order)
+	if err := status.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid project status",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Aynı key veya "live"/"Live" gibi aynı sayılacak bir status varsa ekleme
+	statuses, err := h.statusesRepo.GetAllStatuses()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get project statuses",
+			"details": err.Error(),
+		})
+		return
+	}
+	if existing := models.MatchProjectStatus(statuses, status.Key); existing != nil {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "Project status already exists",
+			"details": existing.Key,
+		})
+		return
+	}
+
+	if err := h.statusesRepo.SaveStatus(status); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to create project status",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.publishStatuses()
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Project status created successfully",
+		"status":  status,
+	})
+}
+
+// UpdateProjectStatus - Label, renk veya sıra güncelle (key değişmez)
+// PUT /api/v1/project-statuses/:key
+func (h *ProjectsHandler) UpdateProjectStatus(c *gin.Context) {
+	var request struct {
+		Label *string `json:"label"`
+		Color *string `json:"color"`
+		Order *int    `json:"order"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	status, err := h.statusesRepo.GetStatus(c.Param("key"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Project status not found",
+		})
+		return
+	}
+
+	if request.Label != nil {
+		status.Label = *request.Label
+	}
+	if request.Color != nil {
+		status.Color = *request.Color
+	}
+	if request.Order != nil {
+		status.Order = *request.Order
+	}
+
+	if err := status.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid project status",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := h.statusesRepo.SaveStatus(status); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update project status",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.publishStatuses()
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Project status updated successfully",
+		"status":  status,
+	})
+}
+
+// DeleteProjectStatus - Status tanımını sil (projesi olan status silinemez)
+// DELETE /api/v1/project-statuses/:key
+func (h *ProjectsHandler) DeleteProjectStatus(c *gin.Context) {
+	key := c.Param("key")
+	if _, err := h.statusesRepo.GetStatus(key); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Project status not found",
+		})
+		return
+	}
+
+	err := h.statusesRepo.DeleteStatus(key)
+	if errors.Is(err, models.ErrStatusInUse) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "Project status in use",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to delete project status",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.publishStatuses()
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Project status deleted successfully",
+	})
+}
+
+// GetGroupedProjects - Tanımlı her status ve projeleri (boş status'lar dahil)
+// GET /api/v1/projects/grouped
+// Response: {"count": 3, "groups": [{"key": "Live", "label": "...", "color": "...", "order": 1, "count": 2, "projects": [...]}]}
+func (h *ProjectsHandler) GetGroupedProjects(c *gin.Context) {
+	statuses, err := h.statusesRepo.GetAllStatuses()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get project statuses",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	groups, err := h.projectsRepo.GetGroupedProjects(statuses)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get projects",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":  len(groups),
+		"groups": groups,
+	})
+}
+
+// publishStatuses - Status listesi değişti, proje sayfaları yeniden oluşturulsun
+func (h *ProjectsHandler) publishStatuses() {
+	statuses, err := h.statusesRepo.GetAllStatuses()
+	if err != nil {
+		return
+	}
+	events.Publish(events.ProjectStatusesUpdated, statuses)
+}
@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"portfolio-backend/events"
 	"portfolio-backend/models"
 	"strconv"
 
@@ -14,6 +16,7 @@ import (
 type ProjectsHandler struct {
 	projectsRepo  *models.ProjectsRepository
 	analyticsRepo *models.AnalyticsRepository
+	statusesRepo  *models.ProjectStatusesRepository
 }
 
 // NewProjectsHandler - Yeni handler oluştur
@@ -21,15 +24,25 @@ func NewProjectsHandler(redisClient *redis.Client) *ProjectsHandler {
 	return &ProjectsHandler{
 		projectsRepo:  models.NewProjectsRepository(redisClient),
 		analyticsRepo: models.NewAnalyticsRepository(redisClient),
+		statusesRepo:  models.NewProjectStatusesRepository(redisClient),
 	}
 }
 
 // GetProjects - V1 API uyumlu projects endpoint
-// GET /api/projects
+// GET /api/projects?sort=manual|latest|popular (default: latest)
 // Response: {"count": 5, "projects": [...]}
 func (h *ProjectsHandler) GetProjects(c *gin.Context) {
+	sortBy := c.DefaultQuery("sort", models.ProjectSortLatest)
+	if !models.IsValidProjectSort(sortBy) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid sort",
+			"details": fmt.Sprintf("sort must be one of: %s, %s, %s", models.ProjectSortManual, models.ProjectSortLatest, models.ProjectSortPopular),
+		})
+		return
+	}
+
 	// Repository'den V1 format response al
-	projectsResponse, err := h.projectsRepo.GetProjectsResponse()
+	projectsResponse, err := h.projectsRepo.GetProjectsResponse(sortBy)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to get projects",
@@ -157,16 +170,40 @@ func (h *ProjectsHandler) GetProjectByID(c *gin.Context) {
 	})
 }
 
+// GetProjectBySlug - Slug'a göre tek proje (case study sayfası)
+// GET /api/v1/projects/by-slug/:slug
+func (h *ProjectsHandler) GetProjectBySlug(c *gin.Context) {
+	project, err := h.projectsRepo.GetProjectBySlug(c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Project not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"project": project,
+	})
+}
+
 // CreateProject - Yeni proje ekle (V2 feature)
 // POST /api/projects
 func (h *ProjectsHandler) CreateProject(c *gin.Context) {
 	var request struct {
-		Title       string                 `json:"title" binding:"required"`
-		Description string                 `json:"description" binding:"required"`
-		Image       string                 `json:"image" binding:"required"`
-		Link        string                 `json:"link"`
-		Tools       []models.ProjectTool   `json:"tools"`
-		Status      string                 `json:"status" binding:"required"`
+		Title       string                   `json:"title" binding:"required"`
+		Description string                   `json:"description" binding:"required"`
+		Image       string                   `json:"image" binding:"required"`
+		Link        string                   `json:"link"`
+		Tools       []models.ProjectTool     `json:"tools"`
+		Status      string                   `json:"status" binding:"required"`
+		Featured    bool                     `json:"featured"`
+		Pinned      bool                     `json:"pinned"`
+		Slug        string                   `json:"slug"`
+		Body        string                   `json:"body"`
+		Role        string                   `json:"role"`
+		Timeframe   *models.ProjectTimeframe `json:"timeframe"`
+		RepoURL     string                   `json:"repo_url"`
+		LiveURL     string                   `json:"live_url"`
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -186,9 +223,38 @@ func (h *ProjectsHandler) CreateProject(c *gin.Context) {
 		request.Status,
 		request.Tools,
 	)
-
-	// Repository'ye kaydet
+	project.Featured = request.Featured
+	project.Pinned = request.Pinned
+	project.Slug = request.Slug
+	project.Body = request.Body
+	project.Role = request.Role
+	project.Timeframe = request.Timeframe
+	project.RepoURL = request.RepoURL
+	project.LiveURL = request.LiveURL
+
+	if err := project.ValidateCaseStudy(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid project",
+			"details": err.Error(),
+		})
+		return
+	}
+	// Repository'ye kaydet (status tanımlı key'e çevrilir)
 	err := h.projectsRepo.CreateProject(project)
+	if errors.Is(err, models.ErrUnknownStatus) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid status",
+			"details": err.Error(),
+		})
+		return
+	}
+	if errors.Is(err, models.ErrSlugTaken) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "Slug already in use",
+			"details": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to create project",
@@ -197,6 +263,8 @@ func (h *ProjectsHandler) CreateProject(c *gin.Context) {
 		return
 	}
 
+	events.Publish(events.ProjectCreated, project)
+
 	// HTTP 201 Created
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "Project created successfully",
@@ -210,12 +278,20 @@ func (h *ProjectsHandler) UpdateProject(c *gin.Context) {
 	projectID := c.Param("id")
 
 	var request struct {
-		Title       *string                `json:"title,omitempty"`
-		Description *string                `json:"description,omitempty"`
-		Image       *string                `json:"image"`  // Image için nil check yapacağız
-		Link        *string                `json:"link,omitempty"`
-		Tools       []models.ProjectTool   `json:"tools,omitempty"`
-		Status      *string                `json:"status,omitempty"`
+		Title       *string                  `json:"title,omitempty"`
+		Description *string                  `json:"description,omitempty"`
+		Image       *string                  `json:"image"`  // Image için nil check yapacağız
+		Link        *string                  `json:"link,omitempty"`
+		Tools       []models.ProjectTool     `json:"tools,omitempty"`
+		Status      *string                  `json:"status,omitempty"`
+		Featured    *bool                    `json:"featured,omitempty"`
+		Pinned      *bool                    `json:"pinned,omitempty"`
+		Slug        *string                  `json:"slug,omitempty"`
+		Body        *string                  `json:"body,omitempty"`
+		Role        *string                  `json:"role,omitempty"`
+		Timeframe   *models.ProjectTimeframe `json:"timeframe,omitempty"`
+		RepoURL     *string                  `json:"repo_url,omitempty"`
+		LiveURL     *string                  `json:"live_url,omitempty"`
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -254,9 +330,59 @@ func (h *ProjectsHandler) UpdateProject(c *gin.Context) {
 	if request.Status != nil && *request.Status != "" {
 		existingProject.Status = *request.Status
 	}
+	// Bool'lar için nil check - false da geçerli değer
+	if request.Featured != nil {
+		existingProject.Featured = *request.Featured
+	}
+	if request.Pinned != nil {
+		existingProject.Pinned = *request.Pinned
+	}
+	// Case study alanları - boş string alanı temizler (slug hariç, slug boşsa korunur)
+	if request.Slug != nil && *request.Slug != "" {
+		existingProject.Slug = *request.Slug
+	}
+	if request.Body != nil {
+		existingProject.Body = *request.Body
+	}
+	if request.Role != nil {
+		existingProject.Role = *request.Role
+	}
+	if request.Timeframe != nil {
+		existingProject.Timeframe = request.Timeframe
+		if request.Timeframe.Start == "" && request.Timeframe.End == "" {
+			existingProject.Timeframe = nil
+		}
+	}
+	if request.RepoURL != nil {
+		existingProject.RepoURL = *request.RepoURL
+	}
+	if request.LiveURL != nil {
+		existingProject.LiveURL = *request.LiveURL
+	}
 
-	// Güncelle
+	if err := existingProject.ValidateCaseStudy(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid project",
+			"details": err.Error(),
+		})
+		return
+	}
+	// Güncelle (status sadece değiştiyse kontrol edilir, eski status'lu projeler de düzenlenebilsin)
 	err = h.projectsRepo.UpdateProject(existingProject)
+	if errors.Is(err, models.ErrUnknownStatus) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid status",
+			"details": err.Error(),
+		})
+		return
+	}
+	if errors.Is(err, models.ErrSlugTaken) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "Slug already in use",
+			"details": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to update project",
@@ -264,19 +390,63 @@ func (h *ProjectsHandler) UpdateProject(c *gin.Context) {
 		return
 	}
 
+	events.Publish(events.ProjectUpdated, existingProject)
+
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Project updated successfully",
 		"project": existingProject,
 	})
 }
 
+// SetProjectOrder - Manuel sıralamayı kaydet (GET /projects?sort=manual)
+// PUT /api/v1/projects/order
+// Body: {"ids": ["project:A", "project:B", ...]} - tüm projeler, istenen sırada
+func (h *ProjectsHandler) SetProjectOrder(c *gin.Context) {
+	var request struct {
+		IDs []string `json:"ids" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := h.projectsRepo.SetOrder(request.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to save project order",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	events.Publish(events.ProjectsReordered, request.IDs)
+
+	projects, err := h.projectsRepo.GetSortedProjects(models.ProjectSortManual)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get projects",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Project order updated successfully",
+		"count":    len(projects),
+		"projects": projects,
+	})
+}
+
 // DeleteProject - Proje sil
 // DELETE /api/projects/:id
 func (h *ProjectsHandler) DeleteProject(c *gin.Context) {
 	projectID := c.Param("id")
 
-	// Önce var mı kontrol et
-	_, err := h.projectsRepo.GetProjectByID(projectID)
+	// Önce var mı kontrol et (silinen hali event ile gönderilir)
+	project, err := h.projectsRepo.GetProjectByID(projectID)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "Project not found",
@@ -293,6 +463,8 @@ func (h *ProjectsHandler) DeleteProject(c *gin.Context) {
 		return
 	}
 
+	events.Publish(events.ProjectDeleted, project)
+
 	// HTTP 204 No Content
 	c.Status(http.StatusNoContent)
 }
@@ -340,6 +512,13 @@ func (h *ProjectsHandler) MigrateV1Projects(c *gin.Context) {
 
 	// Bulk create
 	err := h.projectsRepo.CreateMultipleProjects(request.Projects)
+	if errors.Is(err, models.ErrUnknownStatus) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid status",
+			"details": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to migrate projects",
@@ -352,4 +531,4 @@ func (h *ProjectsHandler) MigrateV1Projects(c *gin.Context) {
 		"message": "Projects migrated successfully",
 		"count":   len(request.Projects),
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedirectsHandler - Eski URL yönlendirmeleri (WordPress permalink'leri vb.)
+type RedirectsHandler struct {
+	redirectsRepo *models.RedirectsRepository
+}
+
+// NewRedirectsHandler - Yeni handler oluştur
+func NewRedirectsHandler(redisClient *redis.Client) *RedirectsHandler {
+	return &RedirectsHandler{
+		redirectsRepo: models.NewRedirectsRepository(redisClient),
+	}
+}
+
+// GetRedirects - Tüm redirect'ler (Next.js build'de redirects() için)
+// GET /api/v1/redirects
+func (h *RedirectsHandler) GetRedirects(c *gin.Context) {
+	redirects, err := h.redirectsRepo.GetAllRedirects()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get redirects",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":     len(redirects),
+		"redirects": redirects,
+	})
+}
+
+// ResolveRedirect - Tek path için redirect hedefi
+// GET /api/v1/redirects/resolve?path=/2019/03/04/hello-world/
+func (h *RedirectsHandler) ResolveRedirect(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "path query parameter is required",
+		})
+		return
+	}
+
+	redirect, err := h.redirectsRepo.GetRedirect(path)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Redirect not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"redirect": redirect,
+	})
+}
+
+// CreateRedirect - Manuel redirect ekle
+// POST /api/v1/redirects
+// Body: {"from": "/old-post/", "to": "/blog/new-post/"}
+func (h *RedirectsHandler) CreateRedirect(c *gin.Context) {
+	var request struct {
+		From string `json:"from" binding:"required"`
+		To   string `json:"to" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if !strings.HasPrefix(request.To, "/") && !strings.HasPrefix(request.To, "https://") {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "to must be a site path or an https URL",
+		})
+		return
+	}
+
+	redirect := models.NewRedirect(request.From, request.To, models.RedirectSourceManual)
+	err := h.redirectsRepo.SaveRedirect(redirect)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to save redirect",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message":  "Redirect created successfully",
+		"redirect": redirect,
+	})
+}
+
+// DeleteRedirect - Redirect sil
+// DELETE /api/v1/redirects?from=/old-post/
+func (h *RedirectsHandler) DeleteRedirect(c *gin.Context) {
+	from := c.Query("from")
+	if from == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "from query parameter is required",
+		})
+		return
+	}
+
+	err := h.redirectsRepo.DeleteRedirect(from)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Redirect not found",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
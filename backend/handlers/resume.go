@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"portfolio-backend/config"
+	"portfolio-backend/models"
+	"portfolio-backend/resume"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// ResumeHandler - Profil, timeline, skill ve projelerden CV üretir
+type ResumeHandler struct {
+	resumeRepo   *models.ResumeRepository
+	skillsRepo   *models.SkillsRepository
+	projectsRepo *models.ProjectsRepository
+	timelineRepo *models.TimelineRepository
+	publicURL    string // JSON Resume meta.canonical için
+}
+
+// NewResumeHandler - Yeni handler oluştur
+func NewResumeHandler(cfg *config.Config, redisClient *redis.Client) *ResumeHandler {
+	return &ResumeHandler{
+		resumeRepo:   models.NewResumeRepository(redisClient),
+		skillsRepo:   models.NewSkillsRepository(redisClient),
+		projectsRepo: models.NewProjectsRepository(redisClient),
+		timelineRepo: models.NewTimelineRepository(redisClient),
+		publicURL:    strings.TrimSuffix(cfg.Newsletter.PublicURL, "/"),
+	}
+}
+
+// GetResumeJSON - JSON Resume formatında CV
+// GET /api/v1/resume.json
+func (h *ResumeHandler) GetResumeJSON(c *gin.Context) {
+	cv, ok := h.build(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, cv.ToJSONResume(h.publicURL+"/api/v1/resume.json"))
+}
+
+// GetResumeMarkdown - Markdown CV
+// GET /api/v1/resume.md
+func (h *ResumeHandler) GetResumeMarkdown(c *gin.Context) {
+	cv, ok := h.build(c)
+	if !ok {
+		return
+	}
+	c.Header("Content-Disposition", `inline; filename="resume.md"`)
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(cv.ToMarkdown()))
+}
+
+// GetResumePDF - A4 PDF CV (?download=true ile dosya olarak iner)
+// GET /api/v1/resume.pdf
+func (h *ResumeHandler) GetResumePDF(c *gin.Context) {
+	cv, ok := h.build(c)
+	if !ok {
+		return
+	}
+	disposition := "inline"
+	if c.Query("download") == "true" {
+		disposition = "attachment"
+	}
+	pdf, missing := cv.ToPDF()
+	if len(missing) > 0 {
+		// Sessizce '?' yazmak yerine kaybolan karakterler log'a ve header'a yazılır
+		codes := make([]string, len(missing))
+		for i, r := range missing {
+			codes[i] = fmt.Sprintf("U+%04X", r)
+		}
+		log.Printf("Resume: PDF fonts have no glyph for %s (%q), written as '?'", strings.Join(codes, ", "), string(missing))
+		c.Header("X-Resume-Missing-Chars", strings.Join(codes, ","))
+	}
+	c.Header("Content-Disposition", disposition+`; filename="resume.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// GetResumeSettings - Profil, deneyimler ve section ayarları (admin)
+// GET /api/v1/resume/settings
+func (h *ResumeHandler) GetResumeSettings(c *gin.Context) {
+	settings, err := h.resumeRepo.GetSettings()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get resume settings",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"settings":           settings,
+		"available_sections": models.ResumeSectionKeys,
+	})
+}
+
+// UpdateResumeSettings - Ayarların tamamını kaydet (admin)
+// PUT /api/v1/resume/settings
+// Body: {"profile": {...}, "experience": [...], "sections": [{"key": "skills", "enabled": true, "include": ["Frontend"]}, ...]}
+// sections sırası CV'deki sıradır, listede olmayan section'lar kapalı olarak sona eklenir
+func (h *ResumeHandler) UpdateResumeSettings(c *gin.Context) {
+	var settings models.ResumeSettings
+	if err := c.ShouldBindJSON(&settings); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := settings.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid resume settings",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := h.resumeRepo.SaveSettings(&settings); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to save resume settings",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Resume settings updated successfully",
+		"settings": settings,
+	})
+}
+
+// build - Ayarları, skill'leri, projeleri ve timeline'ı okuyup CV'yi oluştur
+// Hata olursa response yazılır ve false döner
+func (h *ResumeHandler) build(c *gin.Context) (*resume.Resume, bool) {
+	settings, err := h.resumeRepo.GetSettings()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get resume settings",
+			"details": err.Error(),
+		})
+		return nil, false
+	}
+
+	skills, err := h.skillsRepo.GetSortedSkills(models.SkillQuery{Sort: models.SkillSortOrder})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get skills",
+			"details": err.Error(),
+		})
+		return nil, false
+	}
+
+	projects, err := h.projectsRepo.GetSortedProjects(models.ProjectSortManual)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get projects",
+			"details": err.Error(),
+		})
+		return nil, false
+	}
+
+	timeline, err := h.timelineRepo.GetEntries("")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get timeline",
+			"details": err.Error(),
+		})
+		return nil, false
+	}
+
+	return resume.Build(settings, skills, projects, timeline), true
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"portfolio-backend/config"
+	"portfolio-backend/revalidate"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RevalidateHandler - Next.js revalidation'ı elle tetiklemek için handler (admin)
+type RevalidateHandler struct {
+	client *revalidate.Client
+}
+
+// NewRevalidateHandler - Yeni handler oluştur
+func NewRevalidateHandler(cfg *config.Config) *RevalidateHandler {
+	return &RevalidateHandler{
+		client: revalidate.NewClient(cfg),
+	}
+}
+
+// Revalidate - Verilen path'leri hemen revalidate et (retry dahil, senkron)
+// POST /api/v1/revalidate
+// Body: {"paths": ["/blog", "/blog/my-post"]}
+func (h *RevalidateHandler) Revalidate(c *gin.Context) {
+	if !h.client.Enabled() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error": "Revalidation is not configured (REVALIDATE_URL)",
+		})
+		return
+	}
+
+	var request struct {
+		Paths []string `json:"paths" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	for _, path := range request.Paths {
+		if !strings.HasPrefix(path, "/") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Paths must start with '/'",
+			})
+			return
+		}
+	}
+
+	err := h.client.Revalidate("manual", request.Paths)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error":   "Revalidation failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Paths revalidated successfully",
+		"paths":   request.Paths,
+	})
+}
@@ -1,11 +1,16 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"portfolio-backend/events"
 	"portfolio-backend/models"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -15,6 +20,8 @@ import (
 // SkillsHandler - Skills endpoint'leri için handler
 type SkillsHandler struct {
 	skillsRepo      *models.SkillsRepository
+	projectsRepo    *models.ProjectsRepository
+	redirectsRepo   *models.RedirectsRepository
 	skillsUploadDir string
 }
 
@@ -22,16 +29,53 @@ type SkillsHandler struct {
 func NewSkillsHandler(redisClient *redis.Client) *SkillsHandler {
 	return &SkillsHandler{
 		skillsRepo:      models.NewSkillsRepository(redisClient),
+		projectsRepo:    models.NewProjectsRepository(redisClient),
+		redirectsRepo:   models.NewSkillRedirectsRepository(redisClient),
 		skillsUploadDir: "./skills-upload",
 	}
 }
 
 // GetSkills - V1 API uyumlu skills endpoint
-// GET /api/skills
+// GET /api/skills?sort=order|name|proficiency|experience (default: order)
+// Filtreler: category=Frontend, currently_using=true, min_proficiency=3
 // Response: {"categories": [...], "skills": [...]}
 func (h *SkillsHandler) GetSkills(c *gin.Context) {
+	query := models.SkillQuery{
+		Category: c.Query("category"),
+		Sort:     c.DefaultQuery("sort", models.SkillSortOrder),
+	}
+	if !models.IsValidSkillSort(query.Sort) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid sort",
+			"details": fmt.Sprintf("sort must be one of: %s, %s, %s, %s", models.SkillSortOrder, models.SkillSortName, models.SkillSortProficiency, models.SkillSortExperience),
+		})
+		return
+	}
+	if value := c.Query("currently_using"); value != "" {
+		currentlyUsing, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid currently_using",
+				"details": err.Error(),
+			})
+			return
+		}
+		query.CurrentlyUsing = &currentlyUsing
+	}
+	if value := c.Query("min_proficiency"); value != "" {
+		minProficiency, err := strconv.Atoi(value)
+		if err != nil || minProficiency < 0 || minProficiency > models.MaxSkillProficiency {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid min_proficiency",
+				"details": fmt.Sprintf("min_proficiency must be between 0 and %d", models.MaxSkillProficiency),
+			})
+			return
+		}
+		query.MinProficiency = minProficiency
+	}
+
 	// Repository'den V1 format response al
-	skillsResponse, err := h.skillsRepo.GetSkillsResponse()
+	skillsResponse, err := h.skillsRepo.GetSkillsResponse(query)
 	if err != nil {
 		// HTTP 500 Internal Server Error
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -92,15 +136,54 @@ func (h *SkillsHandler) GetSkillCategories(c *gin.Context) {
 	})
 }
 
+// GetSkillProjects - Skill'i kullanan projeler (skill -> projects reverse index)
+// GET /api/v1/skills/:id/projects
+// Kategori değişikliğiyle taşınmış eski ID'ler yeni ID'ye 301 ile yönlendirilir
+func (h *SkillsHandler) GetSkillProjects(c *gin.Context) {
+	skillID := c.Param("id")
+
+	skill, err := h.skillsRepo.GetSkillByID(skillID)
+	if err != nil {
+		if redirect, redirectErr := h.redirectsRepo.GetRedirect(models.SkillRedirectPath(skillID)); redirectErr == nil {
+			newID := models.SkillIDFromRedirectPath(redirect.To)
+			c.Redirect(http.StatusMovedPermanently, "/api/v1/skills/"+url.PathEscape(newID)+"/projects")
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Skill not found",
+		})
+		return
+	}
+
+	projects, err := h.projectsRepo.GetProjectsBySkill(skillID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get skill projects",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"skill":    skill,
+		"count":    len(projects),
+		"projects": projects,
+	})
+}
+
 // CreateSkill - Yeni skill ekle (V2 feature)
 // POST /api/skills
 // Body: {"category": "Languages", "skill": "Rust", "icon": "/rust.svg"}
 func (h *SkillsHandler) CreateSkill(c *gin.Context) {
 	// Request body'yi parse et
 	var request struct {
-		Category string `json:"category" binding:"required"`
-		Skill    string `json:"skill" binding:"required"`
-		Icon     string `json:"icon"` // Icon opsiyonel
+		Category       string  `json:"category" binding:"required"`
+		Skill          string  `json:"skill" binding:"required"`
+		Icon           string  `json:"icon"` // Icon opsiyonel
+		Proficiency    int     `json:"proficiency"`
+		Years          float64 `json:"years"`
+		FirstUsed      string  `json:"first_used"`
+		CurrentlyUsing bool    `json:"currently_using"`
 	}
 
 	// JSON binding - Go'da validation
@@ -115,6 +198,18 @@ func (h *SkillsHandler) CreateSkill(c *gin.Context) {
 
 	// Yeni skill oluştur
 	skill := models.NewSkill(request.Category, request.Skill, request.Icon)
+	skill.Proficiency = request.Proficiency
+	skill.Years = request.Years
+	skill.FirstUsed = request.FirstUsed
+	skill.CurrentlyUsing = request.CurrentlyUsing
+
+	if err := skill.ValidateExperience(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid skill",
+			"details": err.Error(),
+		})
+		return
+	}
 
 	// Repository'ye kaydet
 	err := h.skillsRepo.CreateSkill(skill)
@@ -126,6 +221,8 @@ func (h *SkillsHandler) CreateSkill(c *gin.Context) {
 		return
 	}
 
+	events.Publish(events.SkillCreated, skill)
+
 	// HTTP 201 Created
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "Skill created successfully",
@@ -135,15 +232,20 @@ func (h *SkillsHandler) CreateSkill(c *gin.Context) {
 
 // UpdateSkill - Skill güncelle
 // PUT /api/skills/:id
+// Kategori değiştirilemez (400), taşıma için POST /api/v1/skills/:id/move
 func (h *SkillsHandler) UpdateSkill(c *gin.Context) {
 	// URL parameter'ı al
 	skillID := c.Param("id")
 
 	// Request body'yi parse et
 	var request struct {
-		Category string `json:"category"`
-		Skill    string `json:"skill"`
-		Icon     string `json:"icon"`
+		Category       string   `json:"category"`
+		Skill          string   `json:"skill"`
+		Icon           string   `json:"icon"`
+		Proficiency    *int     `json:"proficiency"`
+		Years          *float64 `json:"years"`
+		FirstUsed      *string  `json:"first_used"`
+		CurrentlyUsing *bool    `json:"currently_using"`
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -172,9 +274,38 @@ func (h *SkillsHandler) UpdateSkill(c *gin.Context) {
 	if request.Icon != "" {
 		existingSkill.Icon = request.Icon
 	}
+	// Deneyim alanları pointer - 0, "" ve false ile temizlenebilir
+	if request.Proficiency != nil {
+		existingSkill.Proficiency = *request.Proficiency
+	}
+	if request.Years != nil {
+		existingSkill.Years = *request.Years
+	}
+	if request.FirstUsed != nil {
+		existingSkill.FirstUsed = *request.FirstUsed
+	}
+	if request.CurrentlyUsing != nil {
+		existingSkill.CurrentlyUsing = *request.CurrentlyUsing
+	}
+
+	if err := existingSkill.ValidateExperience(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid skill",
+			"details": err.Error(),
+		})
+		return
+	}
 
 	// Güncelle
 	err = h.skillsRepo.UpdateSkill(existingSkill)
+	// Kategori ID'nin parçası: değişiklik referansları ve redirect'i de yazan move endpoint'inden yapılır
+	if errors.Is(err, models.ErrSkillCategoryChange) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Category cannot be changed with update",
+			"details": "use POST /api/v1/skills/" + url.PathEscape(skillID) + "/move to move the skill to another category",
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to update skill",
@@ -182,6 +313,8 @@ func (h *SkillsHandler) UpdateSkill(c *gin.Context) {
 		return
 	}
 
+	events.Publish(events.SkillUpdated, existingSkill)
+
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Skill updated successfully",
 		"skill":   existingSkill,
@@ -216,10 +349,184 @@ func (h *SkillsHandler) DeleteSkill(c *gin.Context) {
 		return
 	}
 
+	events.Publish(events.SkillDeleted, skill)
+
 	// HTTP 204 No Content (successful deletion)
 	c.Status(http.StatusNoContent)
 }
 
+// SetSkillOrder - Kategori içi skill sırasını kaydet
+// PUT /api/v1/skills/order
+// Body: {"category": "Frontend", "ids": ["skill:Frontend:React", ...]} - kategorideki tüm skill'ler, istenen sırada
+func (h *SkillsHandler) SetSkillOrder(c *gin.Context) {
+	var request struct {
+		Category string   `json:"category" binding:"required"`
+		IDs      []string `json:"ids" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := h.skillsRepo.SetSkillOrder(request.Category, request.IDs); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to save skill order",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	events.Publish(events.SkillsReordered, request.IDs)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Skill order updated successfully",
+		"category": request.Category,
+		"ids":      request.IDs,
+	})
+}
+
+// SetSkillCategoryOrder - Kategorilerin görünüm sırasını kaydet
+// PUT /api/v1/skills/categories/order
+// Body: {"categories": ["Languages", "Frontend", ...]} - tüm kategoriler, istenen sırada
+func (h *SkillsHandler) SetSkillCategoryOrder(c *gin.Context) {
+	var request struct {
+		Categories []string `json:"categories" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := h.skillsRepo.SetCategoryOrder(request.Categories); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to save category order",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	events.Publish(events.SkillsReordered, request.Categories)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Category order updated successfully",
+		"categories": request.Categories,
+	})
+}
+
+// RenameSkillCategory - Kategori adını değiştir (skill ID'leri, index'ler ve proje referansları yeniden yazılır)
+// POST /api/v1/skills/categories/rename?dry_run=true
+// Body: {"from": "Libraries", "to": "Frameworks"} - hedef varsa 409 (merge kullanılmalı)
+func (h *SkillsHandler) RenameSkillCategory(c *gin.Context) {
+	var request struct {
+		From string `json:"from" binding:"required"`
+		To   string `json:"to" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	report, err := h.skillsRepo.RenameCategory(request.From, request.To, isDryRun(c))
+	h.moveResponse(c, "Category renamed successfully", report, err)
+}
+
+// MergeSkillCategories - Kaynak kategorideki skill'leri hedefe taşı ve kaynağı kaldır
+// POST /api/v1/skills/categories/merge?dry_run=true
+// Body: {"from": "Libraries", "into": "Frameworks"}
+func (h *SkillsHandler) MergeSkillCategories(c *gin.Context) {
+	var request struct {
+		From string `json:"from" binding:"required"`
+		Into string `json:"into" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	report, err := h.skillsRepo.MergeCategories(request.From, request.Into, isDryRun(c))
+	h.moveResponse(c, "Categories merged successfully", report, err)
+}
+
+// MoveSkill - Skill'i başka kategoriye taşı (yeni ID, CreatedAt korunur)
+// POST /api/v1/skills/:id/move?dry_run=true
+// Body: {"category": "Backend"}
+func (h *SkillsHandler) MoveSkill(c *gin.Context) {
+	var request struct {
+		Category string `json:"category" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if _, err := h.skillsRepo.GetSkillByID(c.Param("id")); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Skill not found",
+		})
+		return
+	}
+
+	report, err := h.skillsRepo.MoveSkill(c.Param("id"), request.Category, isDryRun(c))
+	h.moveResponse(c, "Skill moved successfully", report, err)
+}
+
+// moveResponse - Rename/merge/move sonucunu yaz, başarılıysa taşınan skill'ler için event yayınla
+// Hedefte aynı isimde skill veya kategori varsa 409
+func (h *SkillsHandler) moveResponse(c *gin.Context, message string, report *models.SkillMoveReport, err error) {
+	if errors.Is(err, models.ErrSkillConflict) || errors.Is(err, models.ErrCategoryExists) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "Target already exists",
+			"details": err.Error(),
+			"report":  report,
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to move skills",
+			"details": err.Error(),
+			"report":  report,
+		})
+		return
+	}
+
+	if report.DryRun {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+
+	for _, move := range report.Moves {
+		if skill, err := h.skillsRepo.GetSkillByID(move.To); err == nil {
+			events.Publish(events.SkillUpdated, skill)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": message,
+		"report":  report,
+	})
+}
+
 // deleteSkillIconFile - Skill'e ait icon dosyasını sil
 func (h *SkillsHandler) deleteSkillIconFile(skillName string) {
 	// Skill name pattern'ı ile dosya adını oluştur
@@ -0,0 +1,413 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/contentsync"
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gopkg.in/yaml.v3"
+)
+
+// Sync tetikleyicileri ve sync'e özel action'lar (diğerleri Import* sabitleri)
+const (
+	SyncTriggerStartup = "startup"
+	SyncTriggerWatch   = "watch"
+	SyncTriggerManual  = "manual"
+
+	SyncUpdated = "updated"
+	SyncDeleted = "deleted"
+)
+
+// SyncHandler - Content dizinini (markdown + proje/skill YAML) Redis'e yansıtır
+// Dosyada olmayan ama daha önce sync ile gelmiş entity'ler silinir,
+// admin panelden eklenenlere dokunulmaz
+type SyncHandler struct {
+	dir      string
+	mode     string
+	interval time.Duration
+
+	mu           sync.Mutex // Aynı anda tek sync
+	blog         *BlogHandler
+	blogRepo     *models.BlogRepository
+	projectsRepo *models.ProjectsRepository
+	skillsRepo   *models.SkillsRepository
+	syncRepo     *models.ContentSyncRepository
+}
+
+// NewSyncHandler - Yeni handler oluştur
+func NewSyncHandler(cfg *config.Config, redisClient *redis.Client) *SyncHandler {
+	interval, err := time.ParseDuration(cfg.Sync.Interval)
+	if err != nil {
+		interval = 30 * time.Second
+	}
+
+	return &SyncHandler{
+		dir:          cfg.Sync.Dir,
+		mode:         cfg.Sync.Mode,
+		interval:     interval,
+		blog:         NewBlogHandler(cfg, redisClient),
+		blogRepo:     models.NewBlogRepository(redisClient),
+		projectsRepo: models.NewProjectsRepository(redisClient),
+		skillsRepo:   models.NewSkillsRepository(redisClient),
+		syncRepo:     models.NewContentSyncRepository(redisClient),
+	}
+}
+
+// Enabled - CONTENT_SYNC_DIR tanımlı mı?
+func (h *SyncHandler) Enabled() bool {
+	return h.dir != ""
+}
+
+// Watch - Başlangıçta bir kez sync et, sonra dizindeki değişiklikleri izle
+// main'den goroutine olarak çalıştırılır
+func (h *SyncHandler) Watch(ctx context.Context) {
+	if !h.Enabled() {
+		return
+	}
+
+	h.runLogged(SyncTriggerStartup)
+
+	err := contentsync.Watch(ctx, h.dir, h.mode, h.interval, func() {
+		h.runLogged(SyncTriggerWatch)
+	})
+	if err != nil {
+		log.Printf("Content sync: watcher stopped: %v", err)
+	}
+}
+
+// TriggerSync - Sync'i elle çalıştır
+// POST /api/v1/admin/sync
+func (h *SyncHandler) TriggerSync(c *gin.Context) {
+	if !h.Enabled() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Content sync is not configured (CONTENT_SYNC_DIR)",
+		})
+		return
+	}
+
+	status, err := h.Run(SyncTriggerManual)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Content sync failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Content sync completed",
+		"status":  status,
+	})
+}
+
+// GetSyncStatus - Son sync'in özeti ve dosya bazlı hatalar
+// GET /api/v1/admin/sync/status
+func (h *SyncHandler) GetSyncStatus(c *gin.Context) {
+	// Henüz hiç çalışmadıysa status null döner
+	status, err := h.syncRepo.GetStatus()
+	if err != nil {
+		status = nil
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled": h.Enabled(),
+		"dir":     h.dir,
+		"mode":    h.mode,
+		"status":  status,
+	})
+}
+
+// Run - Dizini tara ve Redis ile uzlaştır
+// Hash'i değişmemiş ve entity'si Redis'te duran dosyalar atlanır (idempotent)
+func (h *SyncHandler) Run(trigger string) (*models.ContentSyncStatus, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	status := &models.ContentSyncStatus{
+		Dir:       h.dir,
+		Trigger:   trigger,
+		StartedAt: time.Now(),
+		Summary:   make(map[string]int),
+		Errors:    []models.ContentSyncItem{},
+		Changes:   []models.ContentSyncItem{},
+	}
+
+	files, err := contentsync.Scan(h.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := h.syncRepo.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	tracked := make(map[string]models.SyncedFile, len(files))
+	seen := make(map[string]bool) // type + id: bu çalışmada dosyası olan entity'ler
+
+	for _, file := range files {
+		item, synced := h.syncFile(file, previous[file.Path])
+		h.record(status, item)
+
+		if synced != nil {
+			tracked[file.Path] = *synced
+			seen[synced.Type+"|"+synced.ID] = true
+		} else if old, ok := previous[file.Path]; ok {
+			// Parse hatası: eski entity silinmesin, bir sonraki çalışmada tekrar denensin
+			old.Hash = ""
+			tracked[file.Path] = old
+			seen[old.Type+"|"+old.ID] = true
+		}
+	}
+
+	// Dosyası silinen (veya ID'si değişen) entity'leri kaldır
+	for filePath, old := range previous {
+		if seen[old.Type+"|"+old.ID] {
+			continue
+		}
+		h.record(status, h.deleteEntity(filePath, old))
+		seen[old.Type+"|"+old.ID] = true
+	}
+
+	if err := h.syncRepo.ReplaceFiles(tracked); err != nil {
+		return nil, err
+	}
+
+	status.FinishedAt = time.Now()
+	status.Duration = status.FinishedAt.Sub(status.StartedAt).String()
+	if err := h.syncRepo.SaveStatus(status); err != nil {
+		fmt.Printf("Warning: Failed to save sync status: %v\n", err)
+	}
+
+	return status, nil
+}
+
+// runLogged - Watcher tetiklemeleri için (sonuç log'a yazılır)
+func (h *SyncHandler) runLogged(trigger string) {
+	status, err := h.Run(trigger)
+	if err != nil {
+		log.Printf("Content sync (%s) failed: %v", trigger, err)
+		return
+	}
+	log.Printf("Content sync (%s): %v in %s", trigger, status.Summary, status.Duration)
+}
+
+func (h *SyncHandler) record(status *models.ContentSyncStatus, item models.ContentSyncItem) {
+	status.Summary[item.Action]++
+	switch item.Action {
+	case ImportFailed:
+		status.Errors = append(status.Errors, item)
+		status.Changes = append(status.Changes, item)
+	case ImportUnchanged:
+	default:
+		status.Changes = append(status.Changes, item)
+	}
+}
+
+// syncFile - Tek dosyayı Redis'e yaz, başarılıysa takip bilgisini döndür
+func (h *SyncHandler) syncFile(file contentsync.File, previous models.SyncedFile) (models.ContentSyncItem, *models.SyncedFile) {
+	item := models.ContentSyncItem{Type: file.Type, Path: file.Path}
+
+	var id string
+	var err error
+	switch file.Type {
+	case contentsync.TypePost:
+		id, item.Action, err = h.syncPost(file, previous)
+	case contentsync.TypeProject:
+		id, item.Action, err = h.syncProject(file, previous)
+	case contentsync.TypeSkill:
+		id, item.Action, err = h.syncSkill(file, previous)
+	default:
+		err = fmt.Errorf("unsupported file type: %s", file.Type)
+	}
+
+	item.ID = id
+	if err != nil {
+		item.Action = ImportFailed
+		item.Error = err.Error()
+		return item, nil
+	}
+
+	return item, &models.SyncedFile{
+		Type:     file.Type,
+		ID:       id,
+		Hash:     file.Hash,
+		SyncedAt: time.Now(),
+	}
+}
+
+// syncPost - MD dosyasını post olarak yaz (dialect otomatik tespit edilir)
+func (h *SyncHandler) syncPost(file contentsync.File, previous models.SyncedFile) (string, string, error) {
+	post, err := h.blog.parseMDContent(string(file.Data), path.Base(file.Path))
+	if err != nil {
+		return "", "", err
+	}
+
+	existing, err := h.blogRepo.GetPostByID(post.ID)
+	if err != nil {
+		if err := h.blogRepo.CreatePost(post); err != nil {
+			return post.ID, "", err
+		}
+		h.blog.publishImported(post)
+		return post.ID, ImportCreated, nil
+	}
+
+	if unchanged(file, previous, post.ID) {
+		return post.ID, ImportUnchanged, nil
+	}
+
+	// Sayaçlar içerik değil, dosyada yoksa Redis'teki değer korunur
+	if post.ViewCount == 0 {
+		post.ViewCount = existing.ViewCount
+	}
+	if err := h.blog.replacePost(existing, post); err != nil {
+		return post.ID, "", err
+	}
+	return post.ID, SyncUpdated, nil
+}
+
+// syncProject - YAML dosyasını proje olarak yaz
+// Mevcut projede dosya mevcut kaydın üzerine okunur: dosyada olmayan alanlar (galeri, pinned, featured...) korunur
+func (h *SyncHandler) syncProject(file contentsync.File, previous models.SyncedFile) (string, string, error) {
+	var project models.Project
+	if err := yaml.Unmarshal(file.Data, &project); err != nil {
+		return "", "", fmt.Errorf("yaml parsing failed: %w", err)
+	}
+	if project.Title == "" {
+		return "", "", fmt.Errorf("title is required")
+	}
+	if project.ID == "" {
+		project.ID = models.NewProject(project.Title, "", "", "", "", nil).ID
+	}
+
+	existing, err := h.projectsRepo.GetProjectByID(project.ID)
+	if err != nil {
+		if project.CreatedAt == "" {
+			project.CreatedAt = time.Now().Format("2006-01-02T15:04:05.000Z")
+		}
+		if err := h.projectsRepo.CreateProject(&project); err != nil {
+			return project.ID, "", err
+		}
+		events.Publish(events.ProjectCreated, &project)
+		return project.ID, ImportCreated, nil
+	}
+
+	if unchanged(file, previous, project.ID) {
+		return project.ID, ImportUnchanged, nil
+	}
+
+	merged := *existing
+	if err := yaml.Unmarshal(file.Data, &merged); err != nil {
+		return project.ID, "", fmt.Errorf("yaml parsing failed: %w", err)
+	}
+	merged.ID = existing.ID
+	// Sayaçlar içerik değil, dosyada 0 ise Redis'teki değer korunur
+	if merged.ViewCount == 0 {
+		merged.ViewCount = existing.ViewCount
+	}
+	if merged.CreatedAt == "" {
+		merged.CreatedAt = existing.CreatedAt
+	}
+	if err := h.projectsRepo.UpdateProject(&merged); err != nil {
+		return project.ID, "", err
+	}
+	events.Publish(events.ProjectUpdated, &merged)
+	return project.ID, SyncUpdated, nil
+}
+
+// syncSkill - YAML dosyasını skill olarak yaz
+// Mevcut skill'de dosya mevcut kaydın üzerine okunur; kategori değişikliği dosya hatası olur (MoveSkill kullanılmalı)
+func (h *SyncHandler) syncSkill(file contentsync.File, previous models.SyncedFile) (string, string, error) {
+	var skill models.Skill
+	if err := yaml.Unmarshal(file.Data, &skill); err != nil {
+		return "", "", fmt.Errorf("yaml parsing failed: %w", err)
+	}
+	if skill.Category == "" || skill.Skill == "" {
+		return "", "", fmt.Errorf("category and skill are required")
+	}
+	if skill.ID == "" {
+		skill.ID = models.NewSkill(skill.Category, skill.Skill, "").ID
+	}
+
+	existing, err := h.skillsRepo.GetSkillByID(skill.ID)
+	if err != nil {
+		if skill.CreatedAt.IsZero() {
+			skill.CreatedAt = time.Now()
+		}
+		if err := h.skillsRepo.CreateSkill(&skill); err != nil {
+			return skill.ID, "", err
+		}
+		events.Publish(events.SkillCreated, &skill)
+		return skill.ID, ImportCreated, nil
+	}
+
+	if unchanged(file, previous, skill.ID) {
+		return skill.ID, ImportUnchanged, nil
+	}
+
+	merged := *existing
+	if err := yaml.Unmarshal(file.Data, &merged); err != nil {
+		return skill.ID, "", fmt.Errorf("yaml parsing failed: %w", err)
+	}
+	merged.ID = existing.ID
+	if merged.CreatedAt.IsZero() {
+		merged.CreatedAt = existing.CreatedAt
+	}
+	if err := h.skillsRepo.UpdateSkill(&merged); err != nil {
+		return skill.ID, "", err
+	}
+	events.Publish(events.SkillUpdated, &merged)
+	return skill.ID, SyncUpdated, nil
+}
+
+// deleteEntity - Dosyası kaldırılmış entity'yi sil
+func (h *SyncHandler) deleteEntity(filePath string, old models.SyncedFile) models.ContentSyncItem {
+	item := models.ContentSyncItem{Type: old.Type, Path: filePath, ID: old.ID, Action: SyncDeleted}
+
+	var err error
+	switch old.Type {
+	case contentsync.TypePost:
+		var post *models.BlogPost
+		if post, err = h.blogRepo.GetPostByID(old.ID); err == nil {
+			if err = h.blogRepo.DeletePost(old.ID); err == nil {
+				events.Publish(events.PostDeleted, post)
+			}
+		}
+	case contentsync.TypeProject:
+		var project *models.Project
+		if project, err = h.projectsRepo.GetProjectByID(old.ID); err == nil {
+			if err = h.projectsRepo.DeleteProject(old.ID); err == nil {
+				events.Publish(events.ProjectDeleted, project)
+			}
+		}
+	case contentsync.TypeSkill:
+		var skill *models.Skill
+		if skill, err = h.skillsRepo.GetSkillByID(old.ID); err == nil {
+			if err = h.skillsRepo.DeleteSkill(old.ID); err == nil {
+				events.Publish(events.SkillDeleted, skill)
+			}
+		}
+	}
+
+	// Zaten silinmişse (admin panelden) sadece takipten çıkar
+	if err != nil {
+		item.Error = err.Error()
+	}
+	return item
+}
+
+// unchanged - Dosya son sync'ten beri değişmemiş mi (aynı path, hash ve entity)
+func unchanged(file contentsync.File, previous models.SyncedFile, id string) bool {
+	return previous.Hash != "" && previous.Hash == file.Hash && previous.ID == id
+}
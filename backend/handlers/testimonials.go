@@ -0,0 +1,558 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// testimonialAvatarDir - Avatar'lar uploads altında ayrı klasörde ("/uploads/testimonials/...")
+const testimonialAvatarDir = "testimonials"
+
+// testimonialAvatarExts - Public form'dan yüklenebilir (SVG script içerebileceği için yok)
+var testimonialAvatarExts = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".webp": true,
+}
+
+// errInvalidAvatar - Avatar boyut, uzantı veya içerik kontrolünden geçemedi
+var errInvalidAvatar = errors.New("invalid avatar")
+
+// TestimonialsHandler - Testimonial, davet ve onay endpoint'leri
+type TestimonialsHandler struct {
+	testimonialsRepo *models.TestimonialsRepository
+	uploadDir        string
+	siteURL          string
+}
+
+// NewTestimonialsHandler - Yeni handler oluştur
+func NewTestimonialsHandler(cfg *config.Config, redisClient *redis.Client) *TestimonialsHandler {
+	return &TestimonialsHandler{
+		testimonialsRepo: models.NewTestimonialsRepository(redisClient),
+		uploadDir:        "./uploads",
+		siteURL:          strings.TrimRight(cfg.Newsletter.SiteURL, "/"),
+	}
+}
+
+// GetTestimonials - Onaylanmış testimonial'lar, öne çıkanlar önce
+// GET /api/v1/testimonials?featured=true&project_id=project:...
+func (h *TestimonialsHandler) GetTestimonials(c *gin.Context) {
+	testimonials, err := h.testimonialsRepo.GetApprovedTestimonials(c.Query("featured") == "true", c.Query("project_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get testimonials",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TestimonialsResponse{
+		Count:        len(testimonials),
+		Testimonials: testimonials,
+	})
+}
+
+// GetInvite - Davet linki geçerli mi? Form'u önceden doldurmak için
+// GET /api/v1/testimonials/invite?token=...
+func (h *TestimonialsHandler) GetInvite(c *gin.Context) {
+	invite, err := h.testimonialsRepo.GetInviteByToken(c.Query("token"))
+	if err != nil {
+		h.inviteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"name":       invite.Name,
+		"project_id": invite.ProjectID,
+		"expires_at": invite.ExpiresAt,
+	})
+}
+
+// SubmitTestimonial - Davet linkiyle testimonial gönder (pending olarak kaydedilir)
+// POST /api/v1/testimonials/submit?token=...
+// Form (multipart): author, role, company, quote, project_id, avatar (dosya, opsiyonel)
+// JSON body de kabul edilir (avatar'sız)
+func (h *TestimonialsHandler) SubmitTestimonial(c *gin.Context) {
+	var request struct {
+		Author    string `json:"author" form:"author" binding:"required,max=100"`
+		Role      string `json:"role" form:"role" binding:"max=100"`
+		Company   string `json:"company" form:"company" binding:"max=100"`
+		Quote     string `json:"quote" form:"quote" binding:"required,min=10,max=2000"`
+		ProjectID string `json:"project_id" form:"project_id"`
+	}
+
+	if err := c.ShouldBind(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Dosyayı yazmadan önce token kontrolü
+	token := c.Query("token")
+	if _, err := h.testimonialsRepo.GetInviteByToken(token); err != nil {
+		h.inviteError(c, err)
+		return
+	}
+
+	testimonial := models.NewTestimonial(request.Author, request.Role, request.Company, request.Quote, request.ProjectID)
+	if err := testimonial.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid testimonial",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	var avatarPath string
+	if header, err := c.FormFile("avatar"); err == nil {
+		avatarPath, err = h.saveAvatar(testimonial, header)
+		if err != nil {
+			h.avatarError(c, err)
+			return
+		}
+	}
+
+	err := h.testimonialsRepo.SubmitTestimonial(token, testimonial)
+	if err != nil {
+		if avatarPath != "" {
+			os.Remove(avatarPath)
+		}
+		if errors.Is(err, models.ErrUnknownProject) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Unknown project",
+				"details": err.Error(),
+			})
+			return
+		}
+		h.inviteError(c, err)
+		return
+	}
+
+	events.Publish(events.TestimonialSubmitted, testimonial)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Testimonial received. Thank you!",
+	})
+}
+
+// GetAllTestimonials - Tüm testimonial'lar (admin), en yeni önce
+// GET /api/v1/testimonials/admin?status=pending
+func (h *TestimonialsHandler) GetAllTestimonials(c *gin.Context) {
+	status := c.Query("status")
+	if status != "" && !models.IsValidTestimonialStatus(status) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          "Invalid status",
+			"allowed_values": models.TestimonialStatuses,
+		})
+		return
+	}
+
+	testimonials, err := h.testimonialsRepo.GetTestimonials(status)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get testimonials",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TestimonialsResponse{
+		Count:        len(testimonials),
+		Testimonials: testimonials,
+	})
+}
+
+// UpdateTestimonial - Testimonial düzenle (sadece gönderilen alanlar)
+// PUT /api/v1/testimonials/:id
+// Boş string opsiyonel alanı temizler (project_id: "" proje bağlantısını kaldırır)
+func (h *TestimonialsHandler) UpdateTestimonial(c *gin.Context) {
+	var request struct {
+		Author    *string `json:"author,omitempty"`
+		Role      *string `json:"role,omitempty"`
+		Company   *string `json:"company,omitempty"`
+		Avatar    *string `json:"avatar,omitempty"`
+		Quote     *string `json:"quote,omitempty"`
+		ProjectID *string `json:"project_id,omitempty"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	testimonial, ok := h.testimonial(c)
+	if !ok {
+		return
+	}
+
+	if request.Author != nil {
+		testimonial.Author = strings.TrimSpace(*request.Author)
+	}
+	if request.Role != nil {
+		testimonial.Role = strings.TrimSpace(*request.Role)
+	}
+	if request.Company != nil {
+		testimonial.Company = strings.TrimSpace(*request.Company)
+	}
+	if request.Avatar != nil {
+		testimonial.Avatar = strings.TrimSpace(*request.Avatar)
+	}
+	if request.Quote != nil {
+		testimonial.Quote = strings.TrimSpace(*request.Quote)
+	}
+	if request.ProjectID != nil {
+		testimonial.ProjectID = strings.TrimSpace(*request.ProjectID)
+	}
+
+	if err := testimonial.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid testimonial",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.saveResponse(c, "Testimonial updated successfully", testimonial, h.testimonialsRepo.UpdateTestimonial(testimonial))
+}
+
+// UploadAvatar - Avatar yükle veya değiştir (admin)
+// POST /api/v1/testimonials/:id/avatar
+// Form: avatar (dosya)
+func (h *TestimonialsHandler) UploadAvatar(c *gin.Context) {
+	testimonial, ok := h.testimonial(c)
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("avatar")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "No file provided",
+		})
+		return
+	}
+
+	// Uzantı değişirse eski dosya kalmasın
+	previous := testimonial.Avatar
+	if _, err := h.saveAvatar(testimonial, header); err != nil {
+		h.avatarError(c, err)
+		return
+	}
+	if previous != testimonial.Avatar {
+		h.deleteAvatarFile(previous)
+	}
+
+	h.saveResponse(c, "Testimonial avatar uploaded successfully", testimonial, h.testimonialsRepo.UpdateTestimonial(testimonial))
+}
+
+// ApproveTestimonial - Onayla (public listede görünür)
+// POST /api/v1/testimonials/:id/approve
+func (h *TestimonialsHandler) ApproveTestimonial(c *gin.Context) {
+	testimonial, ok := h.testimonial(c)
+	if !ok {
+		return
+	}
+	testimonial.SetStatus(models.TestimonialApproved)
+	h.saveResponse(c, "Testimonial approved successfully", testimonial, h.testimonialsRepo.UpdateTestimonial(testimonial))
+}
+
+// RejectTestimonial - Reddet (public listeden kalkar, öne çıkarılmışsa kaldırılır)
+// POST /api/v1/testimonials/:id/reject
+func (h *TestimonialsHandler) RejectTestimonial(c *gin.Context) {
+	testimonial, ok := h.testimonial(c)
+	if !ok {
+		return
+	}
+	testimonial.SetStatus(models.TestimonialRejected)
+	h.saveResponse(c, "Testimonial rejected successfully", testimonial, h.testimonialsRepo.UpdateTestimonial(testimonial))
+}
+
+// FeatureTestimonial - Öne çıkar veya kaldır (sadece approved)
+// PUT /api/v1/testimonials/:id/featured
+// Body: {"featured": true}
+func (h *TestimonialsHandler) FeatureTestimonial(c *gin.Context) {
+	var request struct {
+		Featured *bool `json:"featured" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	testimonial, ok := h.testimonial(c)
+	if !ok {
+		return
+	}
+	testimonial.Featured = *request.Featured
+	h.saveResponse(c, "Testimonial updated successfully", testimonial, h.testimonialsRepo.UpdateTestimonial(testimonial))
+}
+
+// DeleteTestimonial - Testimonial'ı ve avatar dosyasını sil
+// DELETE /api/v1/testimonials/:id
+func (h *TestimonialsHandler) DeleteTestimonial(c *gin.Context) {
+	// Önce var mı kontrol et (silinen hali event ile gönderilir)
+	testimonial, err := h.testimonialsRepo.GetTestimonialByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Testimonial not found",
+		})
+		return
+	}
+
+	if err := h.testimonialsRepo.DeleteTestimonial(testimonial.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to delete testimonial",
+		})
+		return
+	}
+	h.deleteAvatarFile(testimonial.Avatar)
+
+	events.Publish(events.TestimonialDeleted, testimonial)
+
+	// HTTP 204 No Content
+	c.Status(http.StatusNoContent)
+}
+
+// GetInvites - Tüm davetler (admin), en yeni önce
+// GET /api/v1/testimonials/invites
+func (h *TestimonialsHandler) GetInvites(c *gin.Context) {
+	invites, err := h.testimonialsRepo.GetInvites()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get testimonial invites",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":   len(invites),
+		"invites": invites,
+	})
+}
+
+// CreateInvite - Tek kullanımlık davet linki oluştur
+// POST /api/v1/testimonials/invites
+// Body: {"name": "Jane Doe", "email": "jane@example.com", "project_id": "project:...", "expires_in_days": 30}
+func (h *TestimonialsHandler) CreateInvite(c *gin.Context) {
+	var request struct {
+		Name          string `json:"name" binding:"required,max=100"`
+		Email         string `json:"email" binding:"omitempty,email"`
+		ProjectID     string `json:"project_id"`
+		Note          string `json:"note" binding:"max=500"`
+		ExpiresInDays int    `json:"expires_in_days" binding:"omitempty,min=1,max=365"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	days := request.ExpiresInDays
+	if days == 0 {
+		days = 30
+	}
+	invite := models.NewTestimonialInvite(request.Name, request.Email, request.ProjectID, request.Note, time.Hour*24*time.Duration(days))
+
+	err := h.testimonialsRepo.CreateInvite(invite)
+	if errors.Is(err, models.ErrUnknownProject) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Unknown project",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to create testimonial invite",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Testimonial invite created successfully",
+		"invite":  invite,
+		"link":    fmt.Sprintf("%s/testimonials/submit?token=%s", h.siteURL, invite.Token),
+	})
+}
+
+// DeleteInvite - Daveti iptal et (link artık çalışmaz)
+// DELETE /api/v1/testimonials/invites/:id
+func (h *TestimonialsHandler) DeleteInvite(c *gin.Context) {
+	if err := h.testimonialsRepo.DeleteInvite(c.Param("id")); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Testimonial invite not found",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Helper functions
+
+// testimonial - :id'deki testimonial'ı al, yoksa 404 yaz
+func (h *TestimonialsHandler) testimonial(c *gin.Context) (*models.Testimonial, bool) {
+	testimonial, err := h.testimonialsRepo.GetTestimonialByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Testimonial not found",
+		})
+		return nil, false
+	}
+	return testimonial, true
+}
+
+// saveResponse - Admin değişikliğinin sonucunu yaz, başarılıysa event yayınla
+// Approved olmayan testimonial öne çıkarılmak istenirse 409
+func (h *TestimonialsHandler) saveResponse(c *gin.Context, message string, testimonial *models.Testimonial, err error) {
+	switch {
+	case err == nil:
+	case errors.Is(err, models.ErrTestimonialNotApproved):
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "Only approved testimonials can be featured",
+			"details": err.Error(),
+		})
+		return
+	case errors.Is(err, models.ErrUnknownProject):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Unknown project",
+			"details": err.Error(),
+		})
+		return
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update testimonial",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	events.Publish(events.TestimonialUpdated, testimonial)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     message,
+		"testimonial": testimonial,
+	})
+}
+
+// inviteError - Davet token hatalarını HTTP response'a çevir
+func (h *TestimonialsHandler) inviteError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrInviteExpired):
+		c.JSON(http.StatusGone, gin.H{
+			"error": "Invite link has expired",
+		})
+	case errors.Is(err, models.ErrInviteUsed):
+		c.JSON(http.StatusGone, gin.H{
+			"error": "Invite link has already been used",
+		})
+	case errors.Is(err, models.ErrInvalidInvite):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid invite link",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to save testimonial",
+			"details": err.Error(),
+		})
+	}
+}
+
+// avatarError - Avatar hatalarını HTTP response'a çevir
+func (h *TestimonialsHandler) avatarError(c *gin.Context, err error) {
+	if errors.Is(err, errInvalidAvatar) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid avatar",
+			"details": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error": "Failed to save avatar",
+	})
+}
+
+// saveAvatar - Avatar'ı kontrol edip kaydet, testimonial.Avatar'ı set et ve dosya yolunu döndür
+// uploads/testimonials/{hex}.{ext} - boyutlar okunamıyorsa dosya görsel kabul edilmez
+func (h *TestimonialsHandler) saveAvatar(testimonial *models.Testimonial, header *multipart.FileHeader) (string, error) {
+	// Dosya boyutu kontrolü (2MB)
+	if header.Size > 2*1024*1024 {
+		return "", fmt.Errorf("%w: file size too large, maximum size is 2MB", errInvalidAvatar)
+	}
+
+	ext := strings.ToLower(filepath.Ext(header.Filename))
+	if !testimonialAvatarExts[ext] {
+		return "", fmt.Errorf("%w: allowed types are JPG, JPEG, PNG, GIF, WEBP", errInvalidAvatar)
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open avatar: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read avatar: %w", err)
+	}
+	if width, height := imageDimensions(data, ext); width == 0 || height == 0 {
+		return "", fmt.Errorf("%w: file is not a valid image", errInvalidAvatar)
+	}
+
+	dir := filepath.Join(h.uploadDir, testimonialAvatarDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create avatar directory: %w", err)
+	}
+
+	filename := testimonial.AvatarName() + ext
+	path := filepath.Join(dir, filename)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to save avatar: %w", err)
+	}
+
+	testimonial.Avatar = fmt.Sprintf("/uploads/%s/%s", testimonialAvatarDir, filename)
+	return path, nil
+}
+
+// deleteAvatarFile - Sadece testimonials klasöründeki dosyalar silinir (URL dışarıdan girilmiş olabilir)
+func (h *TestimonialsHandler) deleteAvatarFile(avatarURL string) {
+	prefix := fmt.Sprintf("/uploads/%s/", testimonialAvatarDir)
+	if !strings.HasPrefix(avatarURL, prefix) || strings.Contains(avatarURL, "..") {
+		return
+	}
+
+	path := filepath.Join(h.uploadDir, filepath.FromSlash(strings.TrimPrefix(avatarURL, "/uploads/")))
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		fmt.Printf("Warning: Failed to delete testimonial avatar %s: %v\n", path, err)
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"portfolio-backend/config"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestTestimonialInviteSingleUse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Chdir(t.TempDir())
+	_, client := newTestRedis(t)
+
+	cfg := &config.Config{}
+	cfg.Newsletter.SiteURL = "https://example.com/"
+	h := NewTestimonialsHandler(cfg, client)
+	router := gin.New()
+	router.GET("/testimonials/invite", h.GetInvite)
+	router.POST("/testimonials/submit", h.SubmitTestimonial)
+	router.POST("/testimonials/invites", h.CreateInvite)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/testimonials/invites",
+		strings.NewReader(`{"name": "Jane Doe", "email": "jane@example.com"}`)))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create invite status = %d: %s", w.Code, w.Body)
+	}
+	var created struct {
+		Invite models.TestimonialInvite `json:"invite"`
+		Link   string                   `json:"link"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &created); err != nil {
+		t.Fatalf("invite response: %v", err)
+	}
+	token := created.Invite.Token
+	if want := "https://example.com/testimonials/submit?token=" + token; created.Link != want {
+		t.Errorf("link = %q, want %q", created.Link, want)
+	}
+
+	// submitJSON / submitForm - Davet linkiyle gönderim (form'da avatar dosyası da var)
+	submitJSON := func(token string) *http.Request {
+		body := `{"author": "Jane Doe", "quote": "Great work on the project."}`
+		req := httptest.NewRequest(http.MethodPost, "/testimonials/submit?token="+url.QueryEscape(token), strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+	submitForm := func(token string) *http.Request {
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		mw.WriteField("author", "Jane Doe")
+		mw.WriteField("quote", "Great work on the project.")
+		fw, _ := mw.CreateFormFile("avatar", "jane.png")
+		fw.Write(encodePNG(t, 2, 2))
+		mw.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/testimonials/submit?token="+url.QueryEscape(token), &body)
+		req.Header.Set("Content-Type", mw.FormDataContentType())
+		return req
+	}
+	check := func(token string) *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/testimonials/invite?token="+url.QueryEscape(token), nil)
+	}
+
+	// Adımlar sırayla çalışır: ilk gönderim daveti kullanır
+	steps := []struct {
+		name   string
+		req    *http.Request
+		status int
+	}{
+		{"invite is valid before use", check(token), http.StatusOK},
+		{"unknown token", submitJSON("not-a-token"), http.StatusBadRequest},
+		{"first submission", submitJSON(token), http.StatusCreated},
+		{"second submission", submitJSON(token), http.StatusGone},
+		{"second submission with avatar", submitForm(token), http.StatusGone},
+		{"invite is used", check(token), http.StatusGone},
+	}
+
+	for _, step := range steps {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, step.req)
+		if w.Code != step.status {
+			t.Errorf("%s: status = %d, want %d: %s", step.name, w.Code, step.status, w.Body)
+		}
+	}
+
+	testimonials, err := models.NewTestimonialsRepository(client).GetTestimonials("")
+	if err != nil {
+		t.Fatalf("GetTestimonials: %v", err)
+	}
+	if len(testimonials) != 1 || testimonials[0].Status != models.TestimonialPending {
+		t.Fatalf("testimonials = %+v, want one pending", testimonials)
+	}
+	if testimonials[0].InviteID != created.Invite.ID {
+		t.Errorf("invite_id = %q, want %q", testimonials[0].InviteID, created.Invite.ID)
+	}
+
+	// Reddedilen gönderimin avatar'ı diske yazılmamalı
+	if files, _ := os.ReadDir(filepath.Join("uploads", testimonialAvatarDir)); len(files) != 0 {
+		t.Errorf("avatar files = %d, want 0", len(files))
+	}
+}
@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// TimelineHandler - İş deneyimi, eğitim ve sertifika endpoint'leri (about sayfası)
+type TimelineHandler struct {
+	timelineRepo *models.TimelineRepository
+}
+
+// NewTimelineHandler - Yeni handler oluştur
+func NewTimelineHandler(redisClient *redis.Client) *TimelineHandler {
+	return &TimelineHandler{
+		timelineRepo: models.NewTimelineRepository(redisClient),
+	}
+}
+
+// GetTimeline - Entry'ler, en yeni başlayan önce
+// GET /api/v1/timeline?type=work|education|certification (boşsa hepsi)
+// Response: {"count": 4, "entries": [...]}
+func (h *TimelineHandler) GetTimeline(c *gin.Context) {
+	entryType := c.Query("type")
+	if entryType != "" && !models.IsValidTimelineType(entryType) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid type",
+			"details": fmt.Sprintf("type must be one of: %s", strings.Join(models.TimelineTypes, ", ")),
+		})
+		return
+	}
+
+	response, err := h.timelineRepo.GetTimelineResponse(entryType)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get timeline",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetGroupedTimeline - Her tür ve entry'leri (boş türler dahil)
+// GET /api/v1/timeline/grouped
+// Response: {"count": 3, "groups": [{"type": "work", "count": 2, "entries": [...]}, ...]}
+func (h *TimelineHandler) GetGroupedTimeline(c *gin.Context) {
+	groups, err := h.timelineRepo.GetGroupedEntries()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get timeline",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":  len(groups),
+		"groups": groups,
+	})
+}
+
+// GetTimelineEntry - ID'ye göre tek entry
+// GET /api/v1/timeline/:id
+func (h *TimelineHandler) GetTimelineEntry(c *gin.Context) {
+	entry, err := h.timelineRepo.GetEntryByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Timeline entry not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"entry": entry,
+	})
+}
+
+// CreateTimelineEntry - Yeni entry ekle
+// POST /api/v1/timeline
+// Body: {"type": "work", "organization": "Acme", "role": "Backend Developer", "start": "2021-03", "skill_ids": ["skill:Backend:Go"]}
+func (h *TimelineHandler) CreateTimelineEntry(c *gin.Context) {
+	var request struct {
+		Type         string   `json:"type" binding:"required"`
+		Organization string   `json:"organization" binding:"required"`
+		Role         string   `json:"role" binding:"required"`
+		URL          string   `json:"url"`
+		Location     string   `json:"location"`
+		Start        string   `json:"start" binding:"required"`
+		End          string   `json:"end"`
+		Description  string   `json:"description"`
+		SkillIDs     []string `json:"skill_ids"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	entry := models.NewTimelineEntry(request.Type, request.Organization, request.Role, request.Start, request.End)
+	entry.URL = request.URL
+	entry.Location = request.Location
+	entry.Description = request.Description
+	if request.SkillIDs != nil {
+		entry.SkillIDs = request.SkillIDs
+	}
+
+	if err := entry.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid timeline entry",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	err := h.timelineRepo.CreateEntry(entry)
+	if errors.Is(err, models.ErrUnknownSkill) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Unknown skill",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to create timeline entry",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	events.Publish(events.TimelineCreated, entry)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Timeline entry created successfully",
+		"entry":   entry,
+	})
+}
+
+// UpdateTimelineEntry - Entry güncelle (sadece gönderilen alanlar)
+// PUT /api/v1/timeline/:id
+// Boş string opsiyonel alanı temizler (end: "" devam ediyor demektir)
+func (h *TimelineHandler) UpdateTimelineEntry(c *gin.Context) {
+	var request struct {
+		Type         *string  `json:"type,omitempty"`
+		Organization *string  `json:"organization,omitempty"`
+		Role         *string  `json:"role,omitempty"`
+		URL          *string  `json:"url,omitempty"`
+		Location     *string  `json:"location,omitempty"`
+		Start        *string  `json:"start,omitempty"`
+		End          *string  `json:"end,omitempty"`
+		Description  *string  `json:"description,omitempty"`
+		SkillIDs     []string `json:"skill_ids,omitempty"` // Gönderilirse tamamen değişir ([] hepsini kaldırır)
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	entry, err := h.timelineRepo.GetEntryByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Timeline entry not found",
+		})
+		return
+	}
+
+	if request.Type != nil {
+		entry.Type = *request.Type
+	}
+	if request.Organization != nil {
+		entry.Organization = *request.Organization
+	}
+	if request.Role != nil {
+		entry.Role = *request.Role
+	}
+	if request.URL != nil {
+		entry.URL = *request.URL
+	}
+	if request.Location != nil {
+		entry.Location = *request.Location
+	}
+	if request.Start != nil {
+		entry.Start = *request.Start
+	}
+	if request.End != nil {
+		entry.End = *request.End
+	}
+	if request.Description != nil {
+		entry.Description = *request.Description
+	}
+	if request.SkillIDs != nil {
+		entry.SkillIDs = request.SkillIDs
+	}
+
+	if err := entry.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid timeline entry",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	err = h.timelineRepo.UpdateEntry(entry)
+	if errors.Is(err, models.ErrUnknownSkill) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Unknown skill",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update timeline entry",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	events.Publish(events.TimelineUpdated, entry)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Timeline entry updated successfully",
+		"entry":   entry,
+	})
+}
+
+// DeleteTimelineEntry - Entry sil
+// DELETE /api/v1/timeline/:id
+func (h *TimelineHandler) DeleteTimelineEntry(c *gin.Context) {
+	// Önce var mı kontrol et (silinen hali event ile gönderilir)
+	entry, err := h.timelineRepo.GetEntryByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Timeline entry not found",
+		})
+		return
+	}
+
+	if err := h.timelineRepo.DeleteEntry(entry.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to delete timeline entry",
+		})
+		return
+	}
+
+	events.Publish(events.TimelineDeleted, entry)
+
+	// HTTP 204 No Content
+	c.Status(http.StatusNoContent)
+}
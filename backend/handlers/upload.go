@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"portfolio-backend/events"
 	"portfolio-backend/models"
 
 	"github.com/gin-gonic/gin"
@@ -164,28 +165,29 @@ func (h *UploadHandler) updateProjectImageInRedis(projectID, imageURL string) er
 		return fmt.Errorf("failed to update project %s in Redis: %v", projectID, err)
 	}
 
+	events.Publish(events.ProjectUpdated, project)
+
 	fmt.Printf("Project %s image updated to %s in Redis\n", projectID, imageURL)
 	return nil
 }
 
 // generateProjectImageName - Proje resimleri için akıllı isim oluştur
 func (h *UploadHandler) generateProjectImageName(projectID, ext string) string {
-	// Güvenli dosya ismi oluştur - : karakterini - ile değiştir
+	// Format: {safe-id}-main.{ext}
+	return fmt.Sprintf("%s-main%s", safeProjectFileID(projectID), ext)
+}
+
+// safeProjectFileID - Proje ID'sinden dosya adı (: ve boşluk -> -, lowercase)
+func safeProjectFileID(projectID string) string {
 	safeID := strings.ReplaceAll(projectID, ":", "-")
 	safeID = strings.ReplaceAll(safeID, " ", "-")
-	safeID = strings.ToLower(safeID)
-	// Format: {safe-id}-main.{ext}
-	return fmt.Sprintf("%s-main%s", safeID, ext)
+	return strings.ToLower(safeID)
 }
 
 // cleanupOldProjectImages - Eski proje resimlerini temizle
 func (h *UploadHandler) cleanupOldProjectImages(projectID string) {
 	// Güvenli dosya ismi oluştur - generateProjectImageName ile aynı logic
-	safeID := strings.ReplaceAll(projectID, ":", "-")
-	safeID = strings.ReplaceAll(safeID, " ", "-")
-	safeID = strings.ToLower(safeID)
-	
-	pattern := fmt.Sprintf("%s-*", safeID)
+	pattern := fmt.Sprintf("%s-*", safeProjectFileID(projectID))
 	
 	// Uploads klasöründeki dosyaları tara
 	files, err := filepath.Glob(filepath.Join(h.uploadDir, pattern))
@@ -741,6 +743,8 @@ func (h *UploadHandler) updateSkillIconInRedis(skillName, imageURL string) error
 		return fmt.Errorf("failed to update skill %s in Redis: %v", skillName, err)
 	}
 
+	events.Publish(events.SkillUpdated, targetSkill)
+
 	fmt.Printf("Skill %s icon updated to %s in Redis\n", skillName, imageURL)
 	return nil
 }
@@ -801,6 +805,9 @@ func (h *UploadHandler) MigrateSkillIconsFromFirebase(c *gin.Context) {
 			continue
 		}
 
+		updated := skill
+		events.Publish(events.SkillUpdated, &updated)
+
 		migratedSkills = append(migratedSkills, skill.Skill)
 		fmt.Printf("Successfully migrated: %s -> %s\n", skill.Skill, newURL)
 	}
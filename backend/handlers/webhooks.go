@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+	"portfolio-backend/webhooks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// WebhooksHandler - Outbound webhook yönetimi için handler (admin)
+type WebhooksHandler struct {
+	webhooksRepo *models.WebhooksRepository
+	dispatcher   *webhooks.Dispatcher
+}
+
+// NewWebhooksHandler - Yeni handler oluştur
+func NewWebhooksHandler(cfg *config.Config, redisClient *redis.Client) *WebhooksHandler {
+	dispatcher := webhooks.NewDispatcher(cfg, redisClient)
+
+	return &WebhooksHandler{
+		webhooksRepo: dispatcher.Repository(),
+		dispatcher:   dispatcher,
+	}
+}
+
+// GetEvents - Abone olunabilecek event listesi
+// GET /api/v1/webhooks/events
+func (h *WebhooksHandler) GetEvents(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"events": events.Names,
+	})
+}
+
+// GetWebhooks - Tüm webhook'lar (secret maskelenmiş)
+// GET /api/v1/webhooks
+func (h *WebhooksHandler) GetWebhooks(c *gin.Context) {
+	all, err := h.webhooksRepo.GetAllWebhooks()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get webhooks",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	masked := make([]models.Webhook, 0, len(all))
+	for _, webhook := range all {
+		masked = append(masked, webhook.Masked())
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":    len(masked),
+		"webhooks": masked,
+	})
+}
+
+// GetWebhook - Tek webhook (secret maskelenmiş)
+// GET /api/v1/webhooks/:id
+func (h *WebhooksHandler) GetWebhook(c *gin.Context) {
+	webhook, err := h.webhooksRepo.GetWebhookByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Webhook not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"webhook": webhook.Masked(),
+	})
+}
+
+// CreateWebhook - Yeni webhook ekle
+// POST /api/v1/webhooks
+// Body: {"url": "https://...", "events": ["post.published"], "secret": "", "description": ""}
+// Secret boş bırakılırsa üretilir ve sadece bu cevapta açık olarak döner
+func (h *WebhooksHandler) CreateWebhook(c *gin.Context) {
+	var request struct {
+		URL         string   `json:"url" binding:"required"`
+		Events      []string `json:"events" binding:"required"`
+		Secret      string   `json:"secret"`
+		Description string   `json:"description"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if msg := validateWebhook(request.URL, request.Events); msg != "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          msg,
+			"allowed_events": events.Names,
+		})
+		return
+	}
+
+	webhook := models.NewWebhook(strings.TrimSpace(request.URL), request.Secret, request.Description, request.Events)
+
+	err := h.webhooksRepo.SaveWebhook(webhook)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to create webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Webhook created successfully",
+		"webhook": webhook,
+	})
+}
+
+// UpdateWebhook - Webhook güncelle
+// PUT /api/v1/webhooks/:id
+func (h *WebhooksHandler) UpdateWebhook(c *gin.Context) {
+	var request struct {
+		URL         *string  `json:"url,omitempty"`
+		Events      []string `json:"events,omitempty"`
+		Secret      *string  `json:"secret,omitempty"`
+		Description *string  `json:"description,omitempty"`
+		Active      *bool    `json:"active,omitempty"`
+	}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request format",
+		})
+		return
+	}
+
+	webhook, err := h.webhooksRepo.GetWebhookByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Webhook not found",
+		})
+		return
+	}
+
+	// Sadece gönderilen field'ları güncelle
+	if request.URL != nil {
+		webhook.URL = strings.TrimSpace(*request.URL)
+	}
+	if len(request.Events) > 0 {
+		webhook.Events = request.Events
+	}
+	if request.Secret != nil && *request.Secret != "" {
+		webhook.Secret = *request.Secret
+	}
+	if request.Description != nil {
+		webhook.Description = *request.Description
+	}
+	if request.Active != nil {
+		webhook.Active = *request.Active
+	}
+
+	if msg := validateWebhook(webhook.URL, webhook.Events); msg != "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          msg,
+			"allowed_events": events.Names,
+		})
+		return
+	}
+
+	webhook.UpdatedAt = time.Now()
+
+	err = h.webhooksRepo.SaveWebhook(webhook)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to update webhook",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook updated successfully",
+		"webhook": webhook.Masked(),
+	})
+}
+
+// DeleteWebhook - Webhook'u ve delivery log'unu sil
+// DELETE /api/v1/webhooks/:id
+func (h *WebhooksHandler) DeleteWebhook(c *gin.Context) {
+	err := h.webhooksRepo.DeleteWebhook(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Webhook not found",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetDeliveries - Webhook'un delivery log'u (en yeni first)
+// GET /api/v1/webhooks/:id/deliveries?limit=50
+func (h *WebhooksHandler) GetDeliveries(c *gin.Context) {
+	webhookID := c.Param("id")
+
+	if _, err := h.webhooksRepo.GetWebhookByID(webhookID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Webhook not found",
+		})
+		return
+	}
+
+	limit := 50
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	deliveries, err := h.webhooksRepo.GetDeliveries(webhookID, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get deliveries",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":      len(deliveries),
+		"deliveries": deliveries,
+	})
+}
+
+// Redeliver - Delivery'yi aynı payload ve event ID ile tekrar gönder
+// POST /api/v1/webhooks/deliveries/:id/redeliver
+func (h *WebhooksHandler) Redeliver(c *gin.Context) {
+	delivery, err := h.dispatcher.Redeliver(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Delivery not found",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":  "Redelivery queued",
+		"delivery": delivery,
+	})
+}
+
+// Helper functions
+
+// validateWebhook - URL http(s) olmalı, event'ler bilinen isimler veya "*"
+func validateWebhook(rawURL string, eventNames []string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "Webhook URL must be an absolute http(s) URL"
+	}
+
+	if len(eventNames) == 0 {
+		return "At least one event is required"
+	}
+
+	for _, name := range eventNames {
+		if name != "*" && !events.IsKnown(name) {
+			return "Unknown event: " + name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/models"
+	"portfolio-backend/wordpress"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// WordPressOptions - WXR import ayarları
+type WordPressOptions struct {
+	UploadsDir string // Yerel wp-content/uploads kopyası ("" ise attachment kopyalanmaz)
+	Overwrite  bool   // Aynı slug'lı post varsa üzerine yaz
+	DryRun     bool   // Redis'e ve diske yazmadan plan döndür
+}
+
+// WordPressImportItem - Tek WordPress yazısının import sonucu
+type WordPressImportItem struct {
+	WordPressID int         `json:"wordpress_id"`
+	Title       string      `json:"title"`
+	Slug        string      `json:"slug,omitempty"`
+	PostID      string      `json:"post_id,omitempty"`
+	Published   bool        `json:"published"`
+	Action      string      `json:"action"`
+	Error       string      `json:"error,omitempty"`
+	Redirects   []string    `json:"redirects,omitempty"`
+	Changes     []FieldDiff `json:"changes,omitempty"` // Dry run'da mevcut post ile farklar
+}
+
+// WordPressReport - WXR import özeti
+type WordPressReport struct {
+	Site        string                 `json:"site"`
+	DryRun      bool                   `json:"dry_run"`
+	Summary     map[string]int         `json:"summary"`
+	Items       []WordPressImportItem  `json:"items"`
+	Ignored     map[string]int         `json:"ignored"` // post_type/status -> adet
+	Attachments *wordpress.Attachments `json:"attachments"`
+	Redirects   int                    `json:"redirects"`
+}
+
+// WordPressHandler - WordPress WXR export'unu blog'a import eder (admin)
+type WordPressHandler struct {
+	blog          *BlogHandler // generateExcerpt, replacePost, publishImported için
+	blogRepo      *models.BlogRepository
+	redirectsRepo *models.RedirectsRepository
+	uploadsDir    string
+	blogUploadDir string
+}
+
+// NewWordPressHandler - Yeni handler oluştur
+func NewWordPressHandler(cfg *config.Config, redisClient *redis.Client) *WordPressHandler {
+	return &WordPressHandler{
+		blog:          NewBlogHandler(cfg, redisClient),
+		blogRepo:      models.NewBlogRepository(redisClient),
+		redirectsRepo: models.NewRedirectsRepository(redisClient),
+		uploadsDir:    cfg.WordPress.UploadsDir,
+		blogUploadDir: "./blog-upload",
+	}
+}
+
+// ImportWXR - WordPress export (WXR XML) dosyasını import et
+// POST /api/v1/admin/import/wordpress?dry_run=true&overwrite=true
+// Form: file=<export.xml>
+// Attachment'lar WORDPRESS_UPLOADS_DIR'daki wp-content/uploads kopyasından blog-upload'a alınır
+func (h *WordPressHandler) ImportWXR(c *gin.Context) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "No WXR file uploaded (form field: file)",
+		})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to open uploaded file",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	report, err := h.Import(file, WordPressOptions{
+		UploadsDir: h.uploadsDir,
+		Overwrite:  isOverwrite(c),
+		DryRun:     isDryRun(c),
+	})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid WXR file",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	message := "WordPress import completed"
+	if report.DryRun {
+		message = "Dry run completed, nothing was written"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": message,
+		"report":  report,
+	})
+}
+
+// Import - WXR'ı parse et, yazıları oluştur/güncelle ve redirect'leri kaydet
+// Endpoint ve wpimport CLI tarafından kullanılır
+func (h *WordPressHandler) Import(r io.Reader, opts WordPressOptions) (*WordPressReport, error) {
+	export, err := wordpress.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := wordpress.NewAttachments(opts.UploadsDir, h.blogUploadDir, opts.DryRun)
+	posts, ignored := export.Posts(attachments)
+
+	report := &WordPressReport{
+		Site:        export.Link,
+		DryRun:      opts.DryRun,
+		Summary:     make(map[string]int),
+		Items:       make([]WordPressImportItem, 0, len(posts)),
+		Ignored:     ignored,
+		Attachments: attachments,
+	}
+
+	seen := make(map[string]int) // Aynı export'ta tekrar eden slug'lar
+	for _, wp := range posts {
+		item := h.importPost(wp, opts, seen)
+		report.Summary[item.Action]++
+		report.Redirects += len(item.Redirects)
+		report.Items = append(report.Items, item)
+	}
+
+	return report, nil
+}
+
+// importPost - Tek yazı: dry run'da plan, değilse create/overwrite/skip
+func (h *WordPressHandler) importPost(wp wordpress.Post, opts WordPressOptions, seen map[string]int) WordPressImportItem {
+	item := WordPressImportItem{
+		WordPressID: wp.WordPressID,
+		Title:       wp.Title,
+		Slug:        wp.Slug,
+		Published:   wp.Published,
+	}
+
+	fail := func(action, message string) WordPressImportItem {
+		item.Action = action
+		item.Error = message
+		return item
+	}
+	failedAction := ImportFailed
+	if opts.DryRun {
+		failedAction = PlanInvalid
+	}
+
+	if wp.Error != "" {
+		return fail(failedAction, wp.Error)
+	}
+	if previous, ok := seen[wp.Slug]; ok {
+		return fail(failedAction, fmt.Sprintf("Slug '%s' is also used by WordPress post %d", wp.Slug, previous))
+	}
+	seen[wp.Slug] = wp.WordPressID
+
+	post := h.buildPost(wp)
+	item.PostID = post.ID
+	redirects := h.redirectsFor(wp, post)
+
+	existing, _ := h.blogRepo.GetPostBySlug(post.Slug)
+
+	if opts.DryRun {
+		switch {
+		case existing == nil:
+			item.Action = PlanCreate
+		case opts.Overwrite:
+			item.Action = PlanUpdate
+		default:
+			item.Action = PlanConflict
+			item.Error = fmt.Sprintf("Slug '%s' already exists", post.Slug)
+		}
+		if existing != nil {
+			item.Changes = diffPosts(existing, post)
+		}
+		if item.Action != PlanConflict {
+			item.Redirects = redirectSources(redirects)
+		}
+		return item
+	}
+
+	switch {
+	case existing == nil:
+		if err := h.blogRepo.CreatePost(post); err != nil {
+			return fail(ImportFailed, err.Error())
+		}
+		h.blog.publishImported(post)
+		item.Action = ImportCreated
+	case opts.Overwrite:
+		if err := h.blog.replacePost(existing, post); err != nil {
+			return fail(ImportFailed, err.Error())
+		}
+		item.Action = ImportOverwritten
+	default:
+		item.Action = ImportSkipped
+		item.Error = fmt.Sprintf("Slug '%s' already exists", post.Slug)
+		return item
+	}
+
+	for _, redirect := range redirects {
+		if err := h.redirectsRepo.SaveRedirect(redirect); err != nil {
+			fmt.Printf("Warning: Failed to save redirect %s: %v\n", redirect.From, err)
+			continue
+		}
+		item.Redirects = append(item.Redirects, redirect.From)
+	}
+
+	return item
+}
+
+// buildPost - WordPress yazısından BlogPost (MD import ile aynı default'lar)
+func (h *WordPressHandler) buildPost(wp wordpress.Post) *models.BlogPost {
+	excerpt := wp.Excerpt
+	if excerpt == "" {
+		excerpt = h.blog.generateExcerpt(wp.Content)
+	}
+	author := wp.Author
+	if author == "" {
+		author = "Serkan Ursavaş"
+	}
+	publishedAt := wp.PublishedAt
+	if publishedAt.IsZero() {
+		publishedAt = time.Now()
+	}
+	updatedAt := wp.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = publishedAt
+	}
+
+	return &models.BlogPost{
+		ID:              "blog:" + wp.Slug,
+		Title:           wp.Title,
+		Slug:            wp.Slug,
+		Content:         wp.Content,
+		Excerpt:         excerpt,
+		Author:          author,
+		PublishedAt:     publishedAt,
+		UpdatedAt:       updatedAt,
+		Tags:            wp.Tags,
+		ReadingTime:     h.blog.calculateReadingTime(wp.Content),
+		Published:       wp.Published,
+		FeaturedImage:   wp.FeaturedImage,
+		MetaDescription: excerpt,
+		Extras: map[string]interface{}{
+			"wordpress_id": wp.WordPressID,
+		},
+	}
+}
+
+// redirectsFor - Eski permalink'lerden yeni blog URL'ine redirect'ler (tekrarsız)
+func (h *WordPressHandler) redirectsFor(wp wordpress.Post, post *models.BlogPost) []*models.Redirect {
+	target := "/blog/" + post.Slug + "/"
+
+	var redirects []*models.Redirect
+	seen := make(map[string]bool)
+	for _, link := range wp.Permalinks {
+		redirect := models.NewRedirect(link, target, models.RedirectSourceWordPress)
+		if redirect.From == "" || redirect.From == "/" || redirect.From == target || seen[redirect.From] {
+			continue
+		}
+		seen[redirect.From] = true
+		redirects = append(redirects, redirect)
+	}
+	return redirects
+}
+
+func redirectSources(redirects []*models.Redirect) []string {
+	sources := make([]string, 0, len(redirects))
+	for _, redirect := range redirects {
+		sources = append(sources, redirect.From)
+	}
+	return sources
+}
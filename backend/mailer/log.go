@@ -0,0 +1,55 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// LogMailer - Development için mailer
+// Mesajı log'a yazar, dir verilmişse .eml dosyası olarak da kaydeder
+type LogMailer struct {
+	dir  string
+	from string
+}
+
+// NewLogMailer - Yeni log mailer oluştur
+func NewLogMailer(dir, from string) *LogMailer {
+	if dir != "" {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			os.MkdirAll(dir, 0755)
+		}
+	}
+
+	return &LogMailer{
+		dir:  dir,
+		from: from,
+	}
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9\-_.@]`)
+
+// Send - Mesajı log'la ve dosyaya yaz
+func (m *LogMailer) Send(msg Message) error {
+	log.Printf("📧 Mail to=%s subject=%q", msg.To, msg.Subject)
+
+	if m.dir == "" {
+		return nil
+	}
+
+	data, err := buildMIME(m.from, msg)
+	if err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), unsafeFilenameChars.ReplaceAllString(msg.To, "_"))
+	err = os.WriteFile(filepath.Join(m.dir, filename), data, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"portfolio-backend/config"
+)
+
+// Message - Gönderilecek e-posta
+// HTML opsiyonel, boşsa sadece text/plain gönderilir
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+	Headers map[string]string // List-Unsubscribe gibi ek header'lar
+}
+
+// Mailer - E-posta gönderim arayüzü
+// SMTP (production, MailHog) ve log (development) implementasyonları var
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// New - Config'e göre mailer oluştur
+// MAIL_DRIVER=smtp -> SMTPMailer, diğer her şey -> LogMailer
+func New(cfg *config.Config) Mailer {
+	switch cfg.Mail.Driver {
+	case "smtp":
+		return NewSMTPMailer(cfg.Mail.Host, cfg.Mail.Port, cfg.Mail.Username, cfg.Mail.Password, cfg.Mail.From)
+	default:
+		return NewLogMailer(cfg.Mail.LogDir, cfg.Mail.From)
+	}
+}
+
+// buildMIME - Message'ı RFC 5322 formatında byte'lara çevir
+func buildMIME(from string, msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+
+	headers := []string{
+		"From: " + from,
+		"To: " + msg.To,
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+	}
+	for key, value := range msg.Headers {
+		headers = append(headers, key+": "+value)
+	}
+
+	// Sadece text
+	if msg.HTML == "" {
+		headers = append(headers,
+			"Content-Type: text/plain; charset=utf-8",
+			"Content-Transfer-Encoding: 8bit",
+		)
+		buf.WriteString(strings.Join(headers, "\r\n"))
+		buf.WriteString("\r\n\r\n")
+		buf.WriteString(normalizeNewlines(msg.Text))
+		return buf.Bytes(), nil
+	}
+
+	// Text + HTML: multipart/alternative
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	}
+	for _, part := range parts {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"8bit"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mime part: %w", err)
+		}
+		w.Write([]byte(normalizeNewlines(part.content)))
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close mime writer: %w", err)
+	}
+
+	headers = append(headers, fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q", writer.Boundary()))
+	buf.WriteString(strings.Join(headers, "\r\n"))
+	buf.WriteString("\r\n\r\n")
+	buf.Write(body.Bytes())
+
+	return buf.Bytes(), nil
+}
+
+// normalizeNewlines - SMTP CRLF bekler
+func normalizeNewlines(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
@@ -0,0 +1,59 @@
+package mailer
+
+import (
+	"fmt"
+	"net/mail"
+	"net/smtp"
+)
+
+// SMTPMailer - SMTP üzerinden gönderim
+// Local test için MailHog: MAIL_HOST=localhost MAIL_PORT=1025 (auth yok)
+type SMTPMailer struct {
+	addr     string
+	username string
+	password string
+	host     string
+	from     string
+}
+
+// NewSMTPMailer - Yeni SMTP mailer oluştur
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	return &SMTPMailer{
+		addr:     host + ":" + port,
+		username: username,
+		password: password,
+		host:     host,
+		from:     from,
+	}
+}
+
+// Send - Mesajı SMTP sunucusuna ilet
+func (m *SMTPMailer) Send(msg Message) error {
+	data, err := buildMIME(m.from, msg)
+	if err != nil {
+		return err
+	}
+
+	// Envelope adresleri "Name <addr>" formatında olamaz
+	fromAddr, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	toAddr, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	// Username yoksa auth'suz gönder (MailHog)
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	err = smtp.SendMail(m.addr, auth, fromAddr.Address, []string{toAddr.Address}, data)
+	if err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", toAddr.Address, err)
+	}
+
+	return nil
+}
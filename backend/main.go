@@ -11,8 +11,11 @@ import (
 	"time"
 
 	"portfolio-backend/config"
+	"portfolio-backend/events"
 	"portfolio-backend/handlers"
 	"portfolio-backend/middleware"
+	"portfolio-backend/revalidate"
+	"portfolio-backend/webhooks"
 
 	"github.com/gin-gonic/gin"
 )
@@ -200,10 +203,39 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 	// Handler'ları oluştur
 	skillsHandler := handlers.NewSkillsHandler(redisClient)
 	projectsHandler := handlers.NewProjectsHandler(redisClient)
-	blogHandler := handlers.NewBlogHandler(redisClient)
+	blogHandler := handlers.NewBlogHandler(cfg, redisClient)
 	analyticsHandler := handlers.NewAnalyticsHandler(redisClient)
 	uploadHandler := handlers.NewUploadHandler(redisClient)
 	authHandler := handlers.NewAuthHandler(cfg, redisClient)
+	newsletterHandler := handlers.NewNewsletterHandler(cfg, redisClient)
+	contactHandler := handlers.NewContactHandler(cfg, redisClient)
+	webhooksHandler := handlers.NewWebhooksHandler(cfg, redisClient)
+	revalidateHandler := handlers.NewRevalidateHandler(cfg)
+	exportHandler := handlers.NewExportHandler(cfg, redisClient)
+	importHandler := handlers.NewImportHandler(cfg, redisClient)
+	wordpressHandler := handlers.NewWordPressHandler(cfg, redisClient)
+	redirectsHandler := handlers.NewRedirectsHandler(redisClient)
+	syncHandler := handlers.NewSyncHandler(cfg, redisClient)
+	backupHandler := handlers.NewBackupHandler(cfg, redisClient)
+	bulkHandler := handlers.NewBulkHandler(redisClient)
+	migrationsHandler := handlers.NewMigrationsHandler(redisClient)
+	resumeHandler := handlers.NewResumeHandler(cfg, redisClient)
+	timelineHandler := handlers.NewTimelineHandler(redisClient)
+	testimonialsHandler := handlers.NewTestimonialsHandler(cfg, redisClient)
+
+	// Content event dinleyicileri
+	events.Subscribe(webhooks.NewDispatcher(cfg, redisClient).HandleEvent)
+	events.Subscribe(revalidate.NewClient(cfg).HandleEvent)
+
+	// Content dizini sync (CONTENT_SYNC_DIR boşsa çalışmaz)
+	if syncHandler.Enabled() {
+		go syncHandler.Watch(context.Background())
+	}
+
+	// Yerel git repository'sine zamanlanmış içerik backup'ı (BACKUP_GIT_DIR boşsa çalışmaz)
+	if backupHandler.Enabled() {
+		go backupHandler.Schedule(context.Background())
+	}
 	
 	// Middleware'ları oluştur
 	authMiddleware := middleware.NewAuthMiddleware(cfg, redisClient)
@@ -235,11 +267,17 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 		// Skills endpoints (public)
 		v1.GET("/skills", skillsHandler.GetSkills)
 		v1.GET("/skills/categories", skillsHandler.GetSkillCategories)
+		v1.GET("/skills/:id/projects", skillsHandler.GetSkillProjects)
 
 		// Skills admin endpoints (protected)
 		skillsAdmin := v1.Group("/skills").Use(authMiddleware.RequireAuth())
 		{
 			skillsAdmin.POST("", skillsHandler.CreateSkill)
+			skillsAdmin.PUT("/order", skillsHandler.SetSkillOrder)
+			skillsAdmin.PUT("/categories/order", skillsHandler.SetSkillCategoryOrder)
+			skillsAdmin.POST("/categories/rename", skillsHandler.RenameSkillCategory)
+			skillsAdmin.POST("/categories/merge", skillsHandler.MergeSkillCategories)
+			skillsAdmin.POST("/:id/move", skillsHandler.MoveSkill)
 			skillsAdmin.PUT("/:id", skillsHandler.UpdateSkill)
 			skillsAdmin.DELETE("/:id", skillsHandler.DeleteSkill)
 			skillsAdmin.POST("/migrate", skillsHandler.MigrateV1Skills)
@@ -250,6 +288,8 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 		v1.GET("/projects/latest", projectsHandler.GetLatestProjects)
 		v1.GET("/projects/popular", projectsHandler.GetPopularProjects)
 		v1.GET("/projects/statuses", projectsHandler.GetProjectStatuses)
+		v1.GET("/projects/grouped", projectsHandler.GetGroupedProjects)
+		v1.GET("/projects/by-slug/:slug", projectsHandler.GetProjectBySlug)
 		v1.GET("/projects/:id", projectsHandler.GetProjectByID)
 		v1.POST("/projects/:id/views", projectsHandler.IncrementProjectViews)
 
@@ -257,11 +297,66 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 		projectsAdmin := v1.Group("/projects").Use(authMiddleware.RequireAuth())
 		{
 			projectsAdmin.POST("", projectsHandler.CreateProject)
+			projectsAdmin.PUT("/order", projectsHandler.SetProjectOrder)
 			projectsAdmin.PUT("/:id", projectsHandler.UpdateProject)
 			projectsAdmin.DELETE("/:id", projectsHandler.DeleteProject)
 			projectsAdmin.POST("/migrate", projectsHandler.MigrateV1Projects)
 		}
 
+		// Project status endpoints (public liste, admin CRUD)
+		v1.GET("/project-statuses", projectsHandler.GetProjectStatusDefinitions)
+		projectStatusesAdmin := v1.Group("/project-statuses").Use(authMiddleware.RequireAuth())
+		{
+			projectStatusesAdmin.POST("", projectsHandler.CreateProjectStatus)
+			projectStatusesAdmin.PUT("/:key", projectsHandler.UpdateProjectStatus)
+			projectStatusesAdmin.DELETE("/:key", projectsHandler.DeleteProjectStatus)
+		}
+
+		// Timeline endpoints (public - about sayfası: iş deneyimi, eğitim, sertifikalar)
+		v1.GET("/timeline", timelineHandler.GetTimeline)
+		v1.GET("/timeline/grouped", timelineHandler.GetGroupedTimeline)
+		v1.GET("/timeline/:id", timelineHandler.GetTimelineEntry)
+
+		// Timeline admin endpoints (protected)
+		timelineAdmin := v1.Group("/timeline").Use(authMiddleware.RequireAuth())
+		{
+			timelineAdmin.POST("", timelineHandler.CreateTimelineEntry)
+			timelineAdmin.PUT("/:id", timelineHandler.UpdateTimelineEntry)
+			timelineAdmin.DELETE("/:id", timelineHandler.DeleteTimelineEntry)
+		}
+
+		// Testimonial endpoints (public - onaylananlar ve davet linkiyle gönderim)
+		v1.GET("/testimonials", testimonialsHandler.GetTestimonials)
+		v1.GET("/testimonials/invite", testimonialsHandler.GetInvite)
+		v1.POST("/testimonials/submit", testimonialsHandler.SubmitTestimonial)
+
+		// Testimonial admin endpoints (protected - davetler ve onay akışı)
+		testimonialsAdmin := v1.Group("/testimonials").Use(authMiddleware.RequireAuth())
+		{
+			testimonialsAdmin.GET("/admin", testimonialsHandler.GetAllTestimonials)
+			testimonialsAdmin.GET("/invites", testimonialsHandler.GetInvites)
+			testimonialsAdmin.POST("/invites", testimonialsHandler.CreateInvite)
+			testimonialsAdmin.DELETE("/invites/:id", testimonialsHandler.DeleteInvite)
+			testimonialsAdmin.PUT("/:id", testimonialsHandler.UpdateTestimonial)
+			testimonialsAdmin.POST("/:id/avatar", testimonialsHandler.UploadAvatar)
+			testimonialsAdmin.POST("/:id/approve", testimonialsHandler.ApproveTestimonial)
+			testimonialsAdmin.POST("/:id/reject", testimonialsHandler.RejectTestimonial)
+			testimonialsAdmin.PUT("/:id/featured", testimonialsHandler.FeatureTestimonial)
+			testimonialsAdmin.DELETE("/:id", testimonialsHandler.DeleteTestimonial)
+		}
+
+		// Resume endpoints (public - skill/proje verisinden üretilir)
+		v1.GET("/resume.json", resumeHandler.GetResumeJSON)
+		v1.GET("/resume.md", resumeHandler.GetResumeMarkdown)
+		v1.GET("/resume.pdf", resumeHandler.GetResumePDF)
+
+		// Resume admin endpoints (protected - profil, deneyim, section sırası)
+		resumeAdmin := v1.Group("/resume").Use(authMiddleware.RequireAuth())
+		{
+			resumeAdmin.GET("/settings", resumeHandler.GetResumeSettings)
+			resumeAdmin.PUT("/settings", resumeHandler.UpdateResumeSettings)
+		}
+
 		// Blog endpoints (public)
 		v1.GET("/blog/posts", blogHandler.GetPosts)
 		v1.GET("/blog/posts/latest", blogHandler.GetLatestPosts)
@@ -269,11 +364,13 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 		v1.GET("/blog/posts/:slug", blogHandler.GetPostBySlug)
 		v1.GET("/blog/tags", blogHandler.GetTags)
 		v1.POST("/blog/posts/:id/views", blogHandler.IncrementPostViews)
+		v1.POST("/blog/posts/:id/reactions", blogHandler.AddReaction)
 
 		// Blog admin endpoints (protected)
 		adminBlog := v1.Group("/blog/admin").Use(authMiddleware.RequireAuth())
 		{
 			adminBlog.GET("/posts", blogHandler.GetAllPostsAdmin)
+			adminBlog.GET("/reactions", blogHandler.GetMostReactedPosts)
 		}
 
 		// Blog management endpoints (protected)
@@ -291,6 +388,82 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 			mdAdmin.POST("/import-md", blogHandler.ImportMD)
 			mdAdmin.POST("/import-bulk", blogHandler.ImportBulkMD)
 			mdAdmin.GET("/export-md/:slug", blogHandler.ExportMD)
+			mdAdmin.GET("/import-dialects", blogHandler.GetImportDialects)
+		}
+
+		// Newsletter endpoints (public)
+		v1.POST("/newsletter/subscribe", newsletterHandler.Subscribe)
+		v1.GET("/newsletter/confirm", newsletterHandler.Confirm)
+		v1.GET("/newsletter/unsubscribe", newsletterHandler.Unsubscribe)
+		v1.POST("/newsletter/unsubscribe", newsletterHandler.Unsubscribe) // One-click unsubscribe
+
+		// Newsletter admin endpoints (protected)
+		newsletterAdmin := v1.Group("/newsletter").Use(authMiddleware.RequireAuth())
+		{
+			newsletterAdmin.GET("/subscribers", newsletterHandler.GetSubscribers)
+			newsletterAdmin.DELETE("/subscribers/:email", newsletterHandler.DeleteSubscriber)
+		}
+
+		// Contact form endpoint (public)
+		v1.POST("/contact", contactHandler.SubmitMessage)
+
+		// Contact admin endpoints (protected)
+		contactAdmin := v1.Group("/contact").Use(authMiddleware.RequireAuth())
+		{
+			contactAdmin.GET("/messages", contactHandler.GetMessages)
+			contactAdmin.GET("/messages/:id", contactHandler.GetMessage)
+			contactAdmin.PUT("/messages/:id", contactHandler.UpdateMessageStatus)
+			contactAdmin.DELETE("/messages/:id", contactHandler.DeleteMessage)
+		}
+
+		// Outbound webhook endpoints (all protected - admin only)
+		webhooksAdmin := v1.Group("/webhooks").Use(authMiddleware.RequireAuth())
+		{
+			webhooksAdmin.GET("", webhooksHandler.GetWebhooks)
+			webhooksAdmin.POST("", webhooksHandler.CreateWebhook)
+			webhooksAdmin.GET("/events", webhooksHandler.GetEvents)
+			webhooksAdmin.GET("/:id", webhooksHandler.GetWebhook)
+			webhooksAdmin.PUT("/:id", webhooksHandler.UpdateWebhook)
+			webhooksAdmin.DELETE("/:id", webhooksHandler.DeleteWebhook)
+			webhooksAdmin.GET("/:id/deliveries", webhooksHandler.GetDeliveries)
+			webhooksAdmin.POST("/deliveries/:id/redeliver", webhooksHandler.Redeliver)
+		}
+
+		// Next.js revalidation (protected - manuel tetikleme)
+		revalidateAdmin := v1.Group("/revalidate").Use(authMiddleware.RequireAuth())
+		{
+			revalidateAdmin.POST("", revalidateHandler.Revalidate)
+		}
+
+		// Site-wide admin tools (protected)
+		siteAdmin := v1.Group("/admin").Use(authMiddleware.RequireAuth())
+		{
+			siteAdmin.GET("/export", exportHandler.ExportAll)
+			siteAdmin.POST("/import", importHandler.ImportAll)
+			siteAdmin.POST("/import/wordpress", wordpressHandler.ImportWXR)
+			siteAdmin.POST("/sync", syncHandler.TriggerSync)
+			siteAdmin.GET("/sync/status", syncHandler.GetSyncStatus)
+			siteAdmin.POST("/backup", backupHandler.RunBackup)
+			siteAdmin.GET("/backup/commits", backupHandler.GetBackupCommits)
+			siteAdmin.POST("/backup/restore", backupHandler.RestoreBackup)
+			siteAdmin.GET("/projects/export", bulkHandler.ExportProjectsFile)
+			siteAdmin.POST("/projects/import", bulkHandler.ImportProjectsFile)
+			siteAdmin.GET("/skills/export", bulkHandler.ExportSkillsFile)
+			siteAdmin.POST("/skills/import", bulkHandler.ImportSkillsFile)
+			siteAdmin.POST("/migrations/project-tools", migrationsHandler.LinkProjectTools)
+			siteAdmin.POST("/migrations/project-slugs", migrationsHandler.AssignProjectSlugs)
+			siteAdmin.POST("/migrations/project-statuses", migrationsHandler.NormalizeProjectStatuses)
+		}
+
+		// Redirect endpoints (public - Next.js eski URL'leri yönlendirir)
+		v1.GET("/redirects", redirectsHandler.GetRedirects)
+		v1.GET("/redirects/resolve", redirectsHandler.ResolveRedirect)
+
+		// Redirect admin endpoints (protected)
+		redirectsAdmin := v1.Group("/redirects").Use(authMiddleware.RequireAuth())
+		{
+			redirectsAdmin.POST("", redirectsHandler.CreateRedirect)
+			redirectsAdmin.DELETE("", redirectsHandler.DeleteRedirect)
 		}
 
 		// Analytics endpoints
@@ -305,6 +478,10 @@ func setupRoutes(router *gin.Engine, cfg *config.Config) {
 			uploadAdmin.POST("", uploadHandler.UploadFile)
 			uploadAdmin.POST("/multiple", uploadHandler.UploadMultiple)
 			uploadAdmin.POST("/project/:id", uploadHandler.UploadProjectImage) // Smart project image naming
+			uploadAdmin.POST("/project/:id/gallery", uploadHandler.UploadGalleryImage)
+			uploadAdmin.PUT("/project/:id/gallery/order", uploadHandler.ReorderGallery)
+			uploadAdmin.PUT("/project/:id/gallery/:imageId", uploadHandler.UpdateGalleryImage)
+			uploadAdmin.DELETE("/project/:id/gallery/:imageId", uploadHandler.DeleteGalleryImage)
 			uploadAdmin.POST("/skill/:skillName", uploadHandler.UploadSkillIcon) // Smart skill icon naming
 			uploadAdmin.POST("/blog-image", uploadHandler.UploadBlogImage) // Blog image upload
 			uploadAdmin.POST("/rename/:projectId", uploadHandler.RenameProjectImage) // Rename timestamp to smart naming
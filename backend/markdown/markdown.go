@@ -0,0 +1,100 @@
+// Package markdown - Blog yazıları ve proje case study'leri için ortak markdown analizi
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	slugUnsafe    = regexp.MustCompile(`[^a-z0-9\-]`)
+	slugDashes    = regexp.MustCompile(`-+`)
+	imagePattern  = regexp.MustCompile(`!\[([^\]]*)\]\(([^)]+)\)`)
+	linkPattern   = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	strongPattern = regexp.MustCompile(`(\*\*|__)(.+?)(\*\*|__)`)
+	emPattern     = regexp.MustCompile(`\*([^*\s][^*]*)\*`)
+	codePattern   = regexp.MustCompile("`([^`]*)`")
+	headingPrefix = regexp.MustCompile(`^#{1,6}\s+`)
+)
+
+// Slug - Title'dan URL-safe slug
+// "Modern CSS Techniques" -> "modern-css-techniques"
+func Slug(title string) string {
+	slug := strings.ToLower(title)
+	slug = strings.ReplaceAll(slug, " ", "-")
+	slug = slugUnsafe.ReplaceAllString(slug, "")
+	slug = slugDashes.ReplaceAllString(slug, "-")
+	slug = strings.Trim(slug, "-")
+	return slug
+}
+
+// Excerpt - Başlık olmayan ilk anlamlı satır (en fazla 150 karakter)
+func Excerpt(content string) string {
+	lines := strings.Split(content, "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if len(line) > 10 && !strings.HasPrefix(line, "#") {
+			if len(line) > 150 {
+				return line[:150] + "..."
+			}
+			return line
+		}
+	}
+	return "No excerpt available"
+}
+
+// ReadingTime - 200 kelime/dakika varsayımı ile "6 min read"
+func ReadingTime(content string) string {
+	words := strings.Fields(content)
+	wordCount := len(words)
+	minutes := wordCount / 200 // 200 words per minute
+
+	if minutes < 1 {
+		return "1 min read"
+	}
+	return fmt.Sprintf("%d min read", minutes)
+}
+
+// ResolveImagePaths - Relative image path'lerini root'tan absolute'a çevir
+// URL'ler, data URI'lar ve zaten "/" ile başlayanlar değişmez
+func ResolveImagePaths(content string) string {
+	return imagePattern.ReplaceAllStringFunc(content, func(match string) string {
+		submatch := imagePattern.FindStringSubmatch(match)
+		if len(submatch) != 3 {
+			return match
+		}
+
+		alt := submatch[1]
+		path := submatch[2]
+
+		// Skip if already absolute URL or data URI
+		if strings.HasPrefix(path, "http://") ||
+			strings.HasPrefix(path, "https://") ||
+			strings.HasPrefix(path, "data:") ||
+			strings.HasPrefix(path, "/") {
+			return match
+		}
+
+		// Relative path - convert to absolute
+		absolutePath := "/" + strings.TrimPrefix(path, "./")
+		return fmt.Sprintf("![%s](%s)", alt, absolutePath)
+	})
+}
+
+// PlainText - Satır içi markdown'ı düz metne çevir (PDF gibi markdown gösteremeyen çıktılar için)
+// Görseller atılır, linkler metnine, vurgu ve kod işaretleri kaldırılır, başlık #'leri silinir
+// Satır yapısı korunur (liste işaretleri çağırana kalır)
+func PlainText(content string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		line = imagePattern.ReplaceAllString(line, "")
+		line = linkPattern.ReplaceAllString(line, "$1")
+		line = strongPattern.ReplaceAllString(line, "$2")
+		line = emPattern.ReplaceAllString(line, "$1")
+		line = codePattern.ReplaceAllString(line, "$1")
+		line = headingPrefix.ReplaceAllString(strings.TrimSpace(line), "")
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Migration progress key'leri
+const (
+	progressHashesKey = "migration:v1:hashes" // Hash: V2 ID -> V1 kaynağının content hash'i
+	progressStateKey  = "migration:v1:state"  // Hash: status, source, started_at, finished_at, last_item
+)
+
+// Migration durumları
+const (
+	stateRunning   = "running"
+	stateCompleted = "completed"
+	stateFailed    = "failed"
+)
+
+// progressStore - Yarıda kalan migration'ın kaldığı yerden devam etmesi için
+// Her item yazıldıktan hemen sonra hash'i kaydedilir
+type progressStore struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+func newProgressStore(client *redis.Client) *progressStore {
+	return &progressStore{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// state - Önceki çalışmanın durumu (hiç çalışmadıysa boş map)
+func (s *progressStore) state() (map[string]string, error) {
+	state, err := s.client.HGetAll(s.ctx, progressStateKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration state: %w", err)
+	}
+	return state, nil
+}
+
+func (s *progressStore) start(source string) error {
+	err := s.client.HSet(s.ctx, progressStateKey,
+		"status", stateRunning,
+		"source", source,
+		"started_at", time.Now().Format(time.RFC3339),
+		"finished_at", "",
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to save migration state: %w", err)
+	}
+	return nil
+}
+
+func (s *progressStore) finish(status string) error {
+	err := s.client.HSet(s.ctx, progressStateKey,
+		"status", status,
+		"finished_at", time.Now().Format(time.RFC3339),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to save migration state: %w", err)
+	}
+	return nil
+}
+
+// hashes - Daha önce migrate edilmiş item'ların hash'leri
+func (s *progressStore) hashes() (map[string]string, error) {
+	hashes, err := s.client.HGetAll(s.ctx, progressHashesKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration progress: %w", err)
+	}
+	return hashes, nil
+}
+
+// markDone - Item başarıyla yazıldı
+func (s *progressStore) markDone(id, hash string) error {
+	pipe := s.client.Pipeline()
+	pipe.HSet(s.ctx, progressHashesKey, id, hash)
+	pipe.HSet(s.ctx, progressStateKey, "last_item", id)
+	if _, err := pipe.Exec(s.ctx); err != nil {
+		return fmt.Errorf("failed to save migration progress: %w", err)
+	}
+	return nil
+}
+
+// reset - Progress'i sil (bir sonraki çalışma her şeyi yeniden karşılaştırır)
+func (s *progressStore) reset() error {
+	if err := s.client.Del(s.ctx, progressHashesKey, progressStateKey).Err(); err != nil {
+		return fmt.Errorf("failed to reset migration progress: %w", err)
+	}
+	return nil
+}
+
+// contentHash - V1 item'ının JSON'undan sha256
+func contentHash(item interface{}) string {
+	data, _ := json.Marshal(item)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// V1 API Response structs
+type V1SkillsResponse struct {
+	Categories []string  `json:"categories"`
+	Skills     []V1Skill `json:"skills"`
+}
+
+type V1Skill struct {
+	Category string `json:"category"`
+	Skill    string `json:"skill"`
+	Icon     string `json:"icon"`
+}
+
+type V1ProjectsResponse struct {
+	Count    int         `json:"count"`
+	Projects []V1Project `json:"projects"`
+}
+
+type V1Project struct {
+	ID          string `json:"_id"`
+	Title       string `json:"title"`
+	Description string `json:"desc"`  // V1'de "desc"
+	Tools       string `json:"tools"` // V1'de JSON string
+	Link        string `json:"link"`
+	Image       string `json:"img"` // V1'de "img"
+	Status      string `json:"status"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+type V1Tool struct {
+	Skill string `json:"skill"`
+	Icon  string `json:"icon"`
+}
+
+// V1Dump - Yerel JSON dump (-dump ile okunur, -save-dump ile API'dan yazılır)
+// İki endpoint'in response'ları olduğu gibi saklanır
+type V1Dump struct {
+	FetchedAt time.Time          `json:"fetched_at"`
+	Skills    V1SkillsResponse   `json:"skills"`
+	Projects  V1ProjectsResponse `json:"projects"`
+}
+
+const (
+	V1_API_BASE = "https://portfolio-apis-eight.vercel.app/api"
+	V1_API_KEY  = "527825"
+)
+
+// v1Client - V1 API erişimi
+type v1Client struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func newV1Client(baseURL, apiKey string) *v1Client {
+	return &v1Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// fetchDump - V1 API'dan skills ve projects'i çek
+func (c *v1Client) fetchDump() (*V1Dump, error) {
+	dump := &V1Dump{FetchedAt: time.Now()}
+
+	if err := c.get("/skills", &dump.Skills); err != nil {
+		return nil, fmt.Errorf("failed to fetch V1 skills: %w", err)
+	}
+	if err := c.get("/projects", &dump.Projects); err != nil {
+		return nil, fmt.Errorf("failed to fetch V1 projects: %w", err)
+	}
+
+	return dump, nil
+}
+
+func (c *v1Client) get(path string, target interface{}) error {
+	req, err := http.NewRequest("GET", c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	// V1 API x-api-key header kullanıyor
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("V1 API returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("failed to parse %s response: %w", path, err)
+	}
+	return nil
+}
+
+// readDump - Yerel dump dosyasını oku
+func readDump(path string) (*V1Dump, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dump: %w", err)
+	}
+
+	var dump V1Dump
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return nil, fmt.Errorf("failed to parse dump: %w", err)
+	}
+	return &dump, nil
+}
+
+// writeDump - API'dan çekilen veriyi sonraki çalışmalar için sakla
+func writeDump(path string, dump *V1Dump) error {
+	data, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
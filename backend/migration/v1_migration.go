@@ -2,54 +2,82 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
+	"os"
 	"portfolio-backend/config"
+	"portfolio-backend/events"
+	"portfolio-backend/handlers"
 	"portfolio-backend/models"
+	"portfolio-backend/revalidate"
+	"portfolio-backend/webhooks"
+	"reflect"
+	"sort"
 	"time"
 )
 
-// V1 API Response structs
-type V1SkillsResponse struct {
-	Categories []string     `json:"categories"`
-	Skills     []V1Skill    `json:"skills"`
-}
+// Item action'ları
+const (
+	actionCreated   = "created"
+	actionUpdated   = "updated"
+	actionUnchanged = "unchanged"
+	actionSkipped   = "skipped" // Hash aynı, önceki çalışmada migrate edilmiş
+	actionFailed    = "failed"
+)
 
-type V1Skill struct {
-	Category string `json:"category"`
-	Skill    string `json:"skill"`
-	Icon     string `json:"icon"`
+// ReportItem - Tek skill/projenin migration sonucu
+type ReportItem struct {
+	Type    string               `json:"type"` // skill, project
+	ID      string               `json:"id"`
+	Action  string               `json:"action"`
+	Changes []handlers.FieldDiff `json:"changes,omitempty"`
+	Error   string               `json:"error,omitempty"`
 }
 
-type V1ProjectsResponse struct {
-	Count    int         `json:"count"`
-	Projects []V1Project `json:"projects"`
+// ValidationResult - V1 kaynağı ile Redis'in alan alan karşılaştırması
+type ValidationResult struct {
+	Checked    int          `json:"checked"`
+	Matched    int          `json:"matched"`
+	Missing    []string     `json:"missing"`
+	Mismatched []ReportItem `json:"mismatched"`
+	V2Skills   int          `json:"v2_skills"`
+	V2Projects int          `json:"v2_projects"`
 }
 
-type V1Project struct {
-	ID          string      `json:"_id"`
-	Title       string      `json:"title"`
-	Description string      `json:"desc"`     // V1'de "desc"
-	Tools       string      `json:"tools"`    // V1'de JSON string
-	Link        string      `json:"link"`
-	Image       string      `json:"img"`      // V1'de "img"
-	Status      string      `json:"status"`
-	CreatedAt   string      `json:"createdAt"`
+// Report - -report ile JSON olarak yazılır
+type Report struct {
+	Source     string            `json:"source"`
+	DryRun     bool              `json:"dry_run"`
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt time.Time         `json:"finished_at"`
+	Summary    map[string]int    `json:"summary"`
+	Items      []ReportItem      `json:"items"`
+	Validation *ValidationResult `json:"validation,omitempty"`
 }
 
-type V1Tool struct {
-	Skill string `json:"skill"`
-	Icon  string `json:"icon"`
+// migrator - Item'ları tek tek yazar, her başarılı item'dan sonra progress kaydedilir
+// Yarıda kesilen çalışma tekrar başlatıldığında hash'i aynı olanlar atlanır
+type migrator struct {
+	skillsRepo   *models.SkillsRepository
+	projectsRepo *models.ProjectsRepository
+	statusesRepo *models.ProjectStatusesRepository
+	progress     *progressStore
+	hashes       map[string]string
+	dryRun       bool
+	report       *Report
 }
 
-const (
-	V1_API_BASE = "https://portfolio-apis-eight.vercel.app/api"
-	V1_API_KEY  = "527825"
-)
-
 func main() {
+	dumpPath := flag.String("dump", "", "Read V1 data from a local JSON dump instead of the API")
+	saveDump := flag.String("save-dump", "", "Save the data fetched from the V1 API to this file")
+	apiURL := flag.String("api-url", "", "V1 API base URL (default: V1_API_URL or the production API)")
+	apiKey := flag.String("api-key", "", "V1 API key (default: V1_API_KEY)")
+	dryRun := flag.Bool("dry-run", false, "Show the diff without writing to Redis")
+	reset := flag.Bool("reset", false, "Forget previous progress and compare every item again")
+	reportPath := flag.String("report", "", "Write the diff report as JSON to this file")
+	flag.Parse()
+
 	fmt.Println("🚀 Portfolio V1 to V2 Data Migration Started")
 	fmt.Println("====================================================")
 
@@ -64,255 +92,491 @@ func main() {
 	defer config.CloseRedis()
 
 	redisClient := config.GetRedisClient()
-	
-	// Create repositories
-	skillsRepo := models.NewSkillsRepository(redisClient)
-	projectsRepo := models.NewProjectsRepository(redisClient)
-
-	// Migration steps
-	fmt.Println("📋 Migration Steps:")
-	fmt.Println("1. Fetch V1 Skills Data")
-	fmt.Println("2. Convert and Import Skills to V2")
-	fmt.Println("3. Fetch V1 Projects Data")
-	fmt.Println("4. Convert and Import Projects to V2")
-	fmt.Println("5. Validate Migration")
-	fmt.Println("")
+	progress := newProgressStore(redisClient)
 
-	// Step 1: Migrate Skills
-	fmt.Println("🔧 Step 1: Migrating Skills...")
-	err = migrateSkills(skillsRepo)
-	if err != nil {
-		log.Fatal("Skills migration failed:", err)
+	// Yazılan skill/projeler sunucudaki gibi webhook'lara ve frontend'e bildirilir
+	dispatcher := webhooks.NewDispatcher(cfg, redisClient)
+	events.Subscribe(dispatcher.HandleEvent)
+	events.Subscribe(revalidate.NewClient(cfg).HandleEvent)
+
+	if *reset && !*dryRun {
+		if err := progress.reset(); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("🧹 Previous migration progress cleared")
 	}
-	fmt.Println("✅ Skills migration completed!")
 
-	// Step 2: Migrate Projects
-	fmt.Println("🔧 Step 2: Migrating Projects...")
-	err = migrateProjects(projectsRepo)
+	// Step 1: Kaynak
+	fmt.Println("📡 Step 1: Loading V1 data...")
+	dump, source, err := loadSource(cfg, *dumpPath, *apiURL, *apiKey)
 	if err != nil {
-		log.Fatal("Projects migration failed:", err)
+		log.Fatal(err)
+	}
+	fmt.Printf("  📊 %s: %d skills, %d projects\n", source, len(dump.Skills.Skills), len(dump.Projects.Projects))
+
+	if *saveDump != "" && *dumpPath == "" {
+		if err := writeDump(*saveDump, dump); err != nil {
+			log.Fatal("Failed to save dump:", err)
+		}
+		fmt.Printf("  💾 Dump saved to %s\n", *saveDump)
 	}
-	fmt.Println("✅ Projects migration completed!")
 
-	// Step 3: Validation
-	fmt.Println("🔍 Step 3: Validation...")
-	err = validateMigration(skillsRepo, projectsRepo)
+	m, err := newMigrator(progress, *dryRun, source)
 	if err != nil {
-		log.Fatal("Migration validation failed:", err)
+		log.Fatal(err)
+	}
+	if state, err := progress.state(); err == nil && state["status"] == stateRunning {
+		fmt.Printf("  ↩️  Resuming previous run (started %s, last item %s)\n", state["started_at"], state["last_item"])
+	}
+	if !*dryRun {
+		if err := progress.start(source); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Step 2-3: Skills ve projects
+	fmt.Println("🔧 Step 2: Migrating Skills...")
+	m.migrateSkills(dump.Skills.Skills)
+	fmt.Println("🔧 Step 3: Migrating Projects...")
+	m.migrateProjects(dump.Projects.Projects)
+
+	if !*dryRun {
+		if err := m.skillsRepo.RebuildIndexes(); err != nil {
+			log.Fatal(err)
+		}
+		if err := m.projectsRepo.RebuildIndexes(); err != nil {
+			log.Fatal(err)
+		}
+
+		// Webhook delivery'leri ve revalidation çıkmadan önce tamamlanmalı (retry'lar dahil)
+		fmt.Println("📣 Waiting for webhook deliveries...")
+		events.Wait()
+		dispatcher.Wait()
+	}
+
+	printReport(m.report)
+
+	// Step 4: Validation (dry-run'da Redis değişmediği için anlamsız)
+	var validationErr error
+	if !*dryRun {
+		fmt.Println("🔍 Step 4: Validation...")
+		m.report.Validation, validationErr = validateMigration(m.skillsRepo, m.projectsRepo, m.statusesRepo, dump)
+	}
+
+	m.report.FinishedAt = time.Now()
+	if *reportPath != "" {
+		data, _ := json.MarshalIndent(m.report, "", "  ")
+		if err := os.WriteFile(*reportPath, data, 0644); err != nil {
+			log.Fatal("Failed to write report:", err)
+		}
+		fmt.Printf("📝 Report written to %s\n", *reportPath)
+	}
+
+	failed := m.report.Summary[actionFailed] > 0 || validationErr != nil
+	if !*dryRun {
+		status := stateCompleted
+		if failed {
+			status = stateFailed
+		}
+		if err := progress.finish(status); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if validationErr != nil {
+		log.Fatal("Migration validation failed: ", validationErr)
+	}
+	if failed {
+		log.Fatalf("Migration finished with %d failed items (rerun to retry them)", m.report.Summary[actionFailed])
 	}
 
 	fmt.Println("")
-	fmt.Println("🎉 Migration Successfully Completed!")
+	if *dryRun {
+		fmt.Println("🔍 Dry run completed - nothing was written")
+	} else {
+		fmt.Println("🎉 Migration Successfully Completed!")
+	}
 	fmt.Println("====================================================")
 }
 
-// migrateSkills - V1 skills'leri V2'ye migrate et
-func migrateSkills(skillsRepo *models.SkillsRepository) error {
-	// V1 API'dan skills'leri çek
-	fmt.Println("  📡 Fetching skills from V1 API...")
-	
-	v1Skills, err := fetchV1Skills()
-	if err != nil {
-		return fmt.Errorf("failed to fetch V1 skills: %w", err)
+// loadSource - Dump varsa dosyadan, yoksa V1 API'dan
+func loadSource(cfg *config.Config, dumpPath, apiURL, apiKey string) (*V1Dump, string, error) {
+	if dumpPath != "" {
+		dump, err := readDump(dumpPath)
+		return dump, "dump:" + dumpPath, err
 	}
 
-	fmt.Printf("  📊 Found %d skills in V1 API\n", len(v1Skills.Skills))
-
-	// V1 skills'leri V2 format'ına convert et
-	fmt.Println("  🔄 Converting V1 skills to V2 format...")
-	
-	var v2Skills []models.Skill
-	for _, v1Skill := range v1Skills.Skills {
-		v2Skill := models.NewSkill(v1Skill.Category, v1Skill.Skill, v1Skill.Icon)
-		v2Skills = append(v2Skills, *v2Skill)
+	if apiURL == "" {
+		apiURL = cfg.V1.APIURL
+	}
+	if apiURL == "" {
+		apiURL = V1_API_BASE
+	}
+	if apiKey == "" {
+		apiKey = cfg.V1.APIKey
+	}
+	if apiKey == "" {
+		apiKey = V1_API_KEY
 	}
 
-	// Bulk import to Redis
-	fmt.Println("  💾 Importing skills to Redis...")
-	err = skillsRepo.CreateMultipleSkills(v2Skills)
+	dump, err := newV1Client(apiURL, apiKey).fetchDump()
+	return dump, "api:" + apiURL, err
+}
+
+func newMigrator(progress *progressStore, dryRun bool, source string) (*migrator, error) {
+	hashes, err := progress.hashes()
 	if err != nil {
-		return fmt.Errorf("failed to import skills: %w", err)
+		return nil, err
 	}
 
-	fmt.Printf("  ✅ Successfully imported %d skills\n", len(v2Skills))
-	return nil
+	return &migrator{
+		skillsRepo:   models.NewSkillsRepository(progress.client),
+		projectsRepo: models.NewProjectsRepository(progress.client),
+		statusesRepo: models.NewProjectStatusesRepository(progress.client),
+		progress:     progress,
+		hashes:       hashes,
+		dryRun:       dryRun,
+		report: &Report{
+			Source:    source,
+			DryRun:    dryRun,
+			StartedAt: time.Now(),
+			Summary:   make(map[string]int),
+			Items:     []ReportItem{},
+		},
+	}, nil
+}
+
+// migrateSkills - V1 skills'leri V2'ye migrate et
+// V2'de eklenen alanlar (CreatedAt vb.) korunur, sadece V1'den gelen alanlar güncellenir
+func (m *migrator) migrateSkills(v1Skills []V1Skill) {
+	seen := make(map[string]bool)
+
+	for _, v1Skill := range v1Skills {
+		expected := convertSkill(v1Skill)
+		item := ReportItem{Type: "skill", ID: expected.ID}
+		hash := contentHash(v1Skill)
+
+		if seen[expected.ID] {
+			m.record(failedItem(item, fmt.Errorf("duplicate skill in V1 source")))
+			continue
+		}
+		seen[expected.ID] = true
+
+		existing, err := m.skillsRepo.GetSkillByID(expected.ID)
+		if err != nil {
+			item.Action = actionCreated
+			m.apply(item, hash, events.SkillCreated, expected, func() error { return m.skillsRepo.CreateSkill(expected) })
+			continue
+		}
+
+		if m.hashes[expected.ID] == hash {
+			item.Action = actionSkipped
+			m.record(item)
+			continue
+		}
+
+		item.Changes = diffSkill(existing, expected)
+		if len(item.Changes) == 0 {
+			item.Action = actionUnchanged
+			m.apply(item, hash, "", nil, func() error { return nil })
+			continue
+		}
+
+		merged := *existing
+		merged.Category = expected.Category
+		merged.Skill = expected.Skill
+		merged.Icon = expected.Icon
+		item.Action = actionUpdated
+		m.apply(item, hash, events.SkillUpdated, &merged, func() error { return m.skillsRepo.UpdateSkill(&merged) })
+	}
 }
 
 // migrateProjects - V1 projects'leri V2'ye migrate et
-func migrateProjects(projectsRepo *models.ProjectsRepository) error {
-	// V1 API'dan projects'leri çek
-	fmt.Println("  📡 Fetching projects from V1 API...")
-	
-	v1Projects, err := fetchV1Projects()
-	if err != nil {
-		return fmt.Errorf("failed to fetch V1 projects: %w", err)
-	}
-
-	fmt.Printf("  📊 Found %d projects in V1 API\n", len(v1Projects.Projects))
-
-	// V1 projects'leri V2 format'ına convert et
-	fmt.Println("  🔄 Converting V1 projects to V2 format...")
-	
-	var v2Projects []models.Project
-	for _, v1Project := range v1Projects.Projects {
-		// V1'deki JSON string tools'u parse et
-		var v1Tools []V1Tool
-		if v1Project.Tools != "" {
-			err := json.Unmarshal([]byte(v1Project.Tools), &v1Tools)
-			if err != nil {
-				fmt.Printf("  ⚠️  Warning: Failed to parse tools for project %s: %v\n", v1Project.Title, err)
-				v1Tools = []V1Tool{} // Empty tools
+// ViewCount ve Featured gibi V2 alanları korunur
+func (m *migrator) migrateProjects(v1Projects []V1Project) {
+	seen := make(map[string]bool)
+
+	for _, v1Project := range v1Projects {
+		expected, warning := convertProject(v1Project)
+		item := ReportItem{Type: "project", ID: expected.ID}
+		hash := contentHash(v1Project)
+
+		if warning != nil {
+			fmt.Printf("  ⚠️  Warning: %s: %v\n", v1Project.Title, warning)
+		}
+		if seen[expected.ID] {
+			m.record(failedItem(item, fmt.Errorf("duplicate project title in V1 source")))
+			continue
+		}
+		seen[expected.ID] = true
+
+		// "live" gibi yazımlar tanımlı key'e çevrilir (diff de bu key ile yapılır), tanımsız status item hatası olur
+		status, err := m.statusesRepo.ResolveStatus(expected.Status)
+		if err != nil {
+			m.record(failedItem(item, err))
+			continue
+		}
+		expected.Status = status
+
+		existing, err := m.projectsRepo.GetProjectByID(expected.ID)
+		if err != nil {
+			created := *expected
+			if created.CreatedAt == "" {
+				created.CreatedAt = created.UpdatedAt.Format("2006-01-02T15:04:05.000Z") // V1 format
 			}
+			item.Action = actionCreated
+			m.apply(item, hash, events.ProjectCreated, &created, func() error { return m.projectsRepo.CreateProject(&created) })
+			continue
 		}
 
-		// V1 tools'u V2 ProjectTool'a convert et
-		var v2Tools []models.ProjectTool
-		for _, v1Tool := range v1Tools {
-			v2Tools = append(v2Tools, models.ProjectTool{
-				Skill: v1Tool.Skill,
-				Icon:  v1Tool.Icon,
-			})
+		if m.hashes[expected.ID] == hash {
+			item.Action = actionSkipped
+			m.record(item)
+			continue
 		}
 
-		// V2 Project oluştur
-		v2Project := models.NewProject(
-			v1Project.Title,
-			v1Project.Description,
-			v1Project.Link,
-			v1Project.Image,
-			v1Project.Status,
-			v2Tools,
-		)
-
-		// V1'deki ID ve CreatedAt'i koru
-		v2Project.ID = "project:" + v1Project.Title // Title-based ID
-		if v1Project.CreatedAt != "" {
-			v2Project.CreatedAt = v1Project.CreatedAt
+		item.Changes = diffProject(existing, expected)
+		if len(item.Changes) == 0 {
+			item.Action = actionUnchanged
+			m.apply(item, hash, "", nil, func() error { return nil })
+			continue
 		}
 
-		v2Projects = append(v2Projects, *v2Project)
+		merged := *existing
+		merged.Title = expected.Title
+		merged.Description = expected.Description
+		merged.Tools = expected.Tools
+		merged.Link = expected.Link
+		merged.Image = expected.Image
+		merged.Status = expected.Status
+		if expected.CreatedAt != "" {
+			merged.CreatedAt = expected.CreatedAt
+		}
+		item.Action = actionUpdated
+		m.apply(item, hash, events.ProjectUpdated, &merged, func() error { return m.projectsRepo.UpdateProject(&merged) })
 	}
+}
 
-	// Bulk import to Redis
-	fmt.Println("  💾 Importing projects to Redis...")
-	err = projectsRepo.CreateMultipleProjects(v2Projects)
-	if err != nil {
-		return fmt.Errorf("failed to import projects: %w", err)
+// apply - Dry-run değilse yaz, event'i yayınla ve progress'i kaydet
+// event boşsa (değişiklik yok) sadece progress kaydedilir
+func (m *migrator) apply(item ReportItem, hash string, event string, data interface{}, write func() error) {
+	if !m.dryRun {
+		if err := write(); err != nil {
+			m.record(failedItem(item, err))
+			return
+		}
+		if event != "" {
+			events.Publish(event, data)
+		}
+		if err := m.progress.markDone(item.ID, hash); err != nil {
+			m.record(failedItem(item, err))
+			return
+		}
 	}
+	m.record(item)
+}
 
-	fmt.Printf("  ✅ Successfully imported %d projects\n", len(v2Projects))
-	return nil
+func (m *migrator) record(item ReportItem) {
+	m.report.Summary[item.Action]++
+	m.report.Items = append(m.report.Items, item)
 }
 
-// fetchV1Skills - V1 API'dan skills'leri çek
-func fetchV1Skills() (*V1SkillsResponse, error) {
-	url := V1_API_BASE + "/skills"
-	
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
+func failedItem(item ReportItem, err error) ReportItem {
+	item.Action = actionFailed
+	item.Error = err.Error()
+	return item
+}
 
-	// V1 API x-api-key header kullanıyor
-	req.Header.Set("x-api-key", V1_API_KEY)
-	req.Header.Set("Content-Type", "application/json")
+// convertSkill - V1 skill'i V2 formatına çevir
+func convertSkill(v1Skill V1Skill) *models.Skill {
+	return models.NewSkill(v1Skill.Category, v1Skill.Skill, v1Skill.Icon)
+}
 
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+// convertProject - V1 projeyi V2 formatına çevir (tools JSON string'i parse edilir)
+func convertProject(v1Project V1Project) (*models.Project, error) {
+	var warning error
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("V1 API returned status %d", resp.StatusCode)
+	// V1'deki JSON string tools'u parse et
+	var v1Tools []V1Tool
+	if v1Project.Tools != "" {
+		if err := json.Unmarshal([]byte(v1Project.Tools), &v1Tools); err != nil {
+			warning = fmt.Errorf("failed to parse tools: %w", err)
+			v1Tools = []V1Tool{}
+		}
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	// V1 tools'u V2 ProjectTool'a convert et
+	var v2Tools []models.ProjectTool
+	for _, v1Tool := range v1Tools {
+		v2Tools = append(v2Tools, models.ProjectTool{
+			Skill: v1Tool.Skill,
+			Icon:  v1Tool.Icon,
+		})
 	}
 
-	var skillsResponse V1SkillsResponse
-	err = json.Unmarshal(body, &skillsResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse skills response: %w", err)
-	}
+	v2Project := models.NewProject(
+		v1Project.Title,
+		v1Project.Description,
+		v1Project.Link,
+		v1Project.Image,
+		v1Project.Status,
+		v2Tools,
+	)
+
+	// V1'deki CreatedAt'i koru, ID title-based
+	// V1'de yoksa boş bırakılır: yeni projede şimdiki zaman, mevcut projede eski değer kullanılır
+	v2Project.ID = "project:" + v1Project.Title
+	v2Project.CreatedAt = v1Project.CreatedAt
+
+	return v2Project, warning
+}
 
-	return &skillsResponse, nil
+// diffSkill - V1'den gelen alanları karşılaştır
+func diffSkill(existing, expected *models.Skill) []handlers.FieldDiff {
+	var diffs []handlers.FieldDiff
+	diffs = appendDiff(diffs, "category", existing.Category, expected.Category)
+	diffs = appendDiff(diffs, "skill", existing.Skill, expected.Skill)
+	diffs = appendDiff(diffs, "icon", existing.Icon, expected.Icon)
+	return diffs
 }
 
-// fetchV1Projects - V1 API'dan projects'leri çek
-func fetchV1Projects() (*V1ProjectsResponse, error) {
-	url := V1_API_BASE + "/projects"
-	
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+// diffProject - V1'den gelen alanları karşılaştır
+func diffProject(existing, expected *models.Project) []handlers.FieldDiff {
+	var diffs []handlers.FieldDiff
+	diffs = appendDiff(diffs, "title", existing.Title, expected.Title)
+	diffs = appendDiff(diffs, "description", existing.Description, expected.Description)
+	diffs = appendDiff(diffs, "link", existing.Link, expected.Link)
+	diffs = appendDiff(diffs, "image", existing.Image, expected.Image)
+	diffs = appendDiff(diffs, "status", existing.Status, expected.Status)
+	if expected.CreatedAt != "" {
+		diffs = appendDiff(diffs, "createdAt", existing.CreatedAt, expected.CreatedAt)
 	}
-
-	// V1 API x-api-key header kullanıyor
-	req.Header.Set("x-api-key", V1_API_KEY)
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+	if len(existing.Tools) > 0 || len(expected.Tools) > 0 {
+		diffs = appendDiff(diffs, "tools", toolsWithoutRefs(existing.Tools), expected.Tools)
 	}
-	defer resp.Body.Close()
+	return diffs
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("V1 API returned status %d", resp.StatusCode)
+// toolsWithoutRefs - V1'de skill referansı yok, karşılaştırmada SkillID'ler yok sayılır
+func toolsWithoutRefs(tools []models.ProjectTool) []models.ProjectTool {
+	stripped := make([]models.ProjectTool, len(tools))
+	for i, tool := range tools {
+		stripped[i] = models.ProjectTool{Skill: tool.Skill, Icon: tool.Icon}
 	}
+	return stripped
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+func appendDiff(diffs []handlers.FieldDiff, field string, old, new interface{}) []handlers.FieldDiff {
+	if reflect.DeepEqual(old, new) {
+		return diffs
 	}
+	return append(diffs, handlers.FieldDiff{Field: field, Old: old, New: new})
+}
 
-	var projectsResponse V1ProjectsResponse
-	err = json.Unmarshal(body, &projectsResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse projects response: %w", err)
+// validateMigration - Her V1 item'ı Redis'teki karşılığı ile alan alan karşılaştır
+// Status'lar migrateProjects'teki gibi tanımlı key'e çevrilip karşılaştırılır ("live" -> "Live")
+func validateMigration(skillsRepo *models.SkillsRepository, projectsRepo *models.ProjectsRepository, statusesRepo *models.ProjectStatusesRepository, dump *V1Dump) (*ValidationResult, error) {
+	result := &ValidationResult{Missing: []string{}, Mismatched: []ReportItem{}}
+
+	fmt.Println("  🔍 Validating skills migration...")
+	for _, v1Skill := range dump.Skills.Skills {
+		expected := convertSkill(v1Skill)
+		result.Checked++
+
+		actual, err := skillsRepo.GetSkillByID(expected.ID)
+		if err != nil {
+			result.Missing = append(result.Missing, expected.ID)
+			continue
+		}
+		if diffs := diffSkill(actual, expected); len(diffs) > 0 {
+			result.Mismatched = append(result.Mismatched, ReportItem{Type: "skill", ID: expected.ID, Changes: diffs})
+			continue
+		}
+		result.Matched++
 	}
 
-	return &projectsResponse, nil
-}
+	fmt.Println("  🔍 Validating projects migration...")
+	for _, v1Project := range dump.Projects.Projects {
+		expected, _ := convertProject(v1Project)
+		result.Checked++
+		if status, err := statusesRepo.ResolveStatus(expected.Status); err == nil {
+			expected.Status = status
+		}
 
-// validateMigration - Migration'ı validate et
-func validateMigration(skillsRepo *models.SkillsRepository, projectsRepo *models.ProjectsRepository) error {
-	fmt.Println("  🔍 Validating skills migration...")
-	
-	// Skills validation
-	skills, err := skillsRepo.GetAllSkills()
-	if err != nil {
-		return fmt.Errorf("failed to get migrated skills: %w", err)
+		actual, err := projectsRepo.GetProjectByID(expected.ID)
+		if err != nil {
+			result.Missing = append(result.Missing, expected.ID)
+			continue
+		}
+		if diffs := diffProject(actual, expected); len(diffs) > 0 {
+			result.Mismatched = append(result.Mismatched, ReportItem{Type: "project", ID: expected.ID, Changes: diffs})
+			continue
+		}
+		result.Matched++
 	}
-	fmt.Printf("  📊 V2 Redis contains %d skills\n", len(skills))
 
-	categories, err := skillsRepo.GetCategories()
+	skills, err := skillsRepo.GetAllSkills()
 	if err != nil {
-		return fmt.Errorf("failed to get skill categories: %w", err)
+		return result, fmt.Errorf("failed to get migrated skills: %w", err)
 	}
-	fmt.Printf("  📂 V2 Redis contains %d skill categories\n", len(categories))
-
-	// Projects validation  
-	fmt.Println("  🔍 Validating projects migration...")
-	
 	projects, err := projectsRepo.GetAllProjects()
 	if err != nil {
-		return fmt.Errorf("failed to get migrated projects: %w", err)
+		return result, fmt.Errorf("failed to get migrated projects: %w", err)
 	}
-	fmt.Printf("  📊 V2 Redis contains %d projects\n", len(projects))
+	result.V2Skills = len(skills)
+	result.V2Projects = len(projects)
 
-	statuses, err := projectsRepo.GetStatuses()
-	if err != nil {
-		return fmt.Errorf("failed to get project statuses: %w", err)
+	fmt.Printf("  📊 %d/%d V1 items match field-by-field\n", result.Matched, result.Checked)
+	fmt.Printf("  📂 V2 Redis contains %d skills and %d projects\n", result.V2Skills, result.V2Projects)
+	for _, id := range result.Missing {
+		fmt.Printf("  ❌ missing: %s\n", id)
+	}
+	for _, item := range result.Mismatched {
+		fmt.Printf("  ❌ mismatch: %s\n", item.ID)
+		printChanges(item.Changes)
+	}
+
+	if len(result.Missing) > 0 || len(result.Mismatched) > 0 {
+		return result, fmt.Errorf("%d missing, %d mismatched", len(result.Missing), len(result.Mismatched))
 	}
-	fmt.Printf("  📂 V2 Redis contains %d project statuses\n", len(statuses))
 
 	fmt.Println("  ✅ Migration validation completed!")
-	
-	return nil
-}
\ No newline at end of file
+	return result, nil
+}
+
+// printReport - Değişen item'lar ve diff'leri
+func printReport(report *Report) {
+	fmt.Println("")
+	fmt.Println("📋 Diff Report:")
+	for _, item := range report.Items {
+		if item.Action == actionSkipped || item.Action == actionUnchanged {
+			continue
+		}
+		line := fmt.Sprintf("  [%s] %s %s", item.Action, item.Type, item.ID)
+		if item.Error != "" {
+			line += " ❌ " + item.Error
+		}
+		fmt.Println(line)
+		printChanges(item.Changes)
+	}
+
+	fmt.Println("")
+	fmt.Println("📊 Summary:")
+	actions := make([]string, 0, len(report.Summary))
+	for action := range report.Summary {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	for _, action := range actions {
+		fmt.Printf("  %s: %d\n", action, report.Summary[action])
+	}
+	fmt.Println("")
+}
+
+func printChanges(changes []handlers.FieldDiff) {
+	for _, change := range changes {
+		old, _ := json.Marshal(change.Old)
+		new, _ := json.Marshal(change.New)
+		fmt.Printf("      %s: %s → %s\n", change.Field, old, new)
+	}
+}
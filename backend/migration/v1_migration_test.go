@@ -0,0 +1,235 @@
+package main
+
+import (
+	"testing"
+
+	"portfolio-backend/models"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func testDump() *V1Dump {
+	return &V1Dump{
+		Skills: V1SkillsResponse{Skills: []V1Skill{
+			{Category: "Frontend", Skill: "React", Icon: "/skills-upload/react.svg"},
+			{Category: "Backend", Skill: "Go", Icon: "/skills-upload/go.svg"},
+		}},
+		Projects: V1ProjectsResponse{Projects: []V1Project{
+			{Title: "Site", Description: "Portfolio", Tools: `[{"skill":"React","icon":"/skills-upload/react.svg"}]`, Link: "https://example.com", Image: "/uploads/site.png", Status: "live", CreatedAt: "2023-01-02T03:04:05.000Z"},
+			{Title: "API", Description: "Backend", Tools: `[]`, Status: "Github", CreatedAt: "2023-02-02T03:04:05.000Z"},
+		}},
+	}
+}
+
+// migrate - Tek migration çalışması (main'deki adımlar, Redis bağlantısı olmadan)
+func migrate(t *testing.T, client *redis.Client, dump *V1Dump, dryRun bool) *Report {
+	t.Helper()
+
+	m, err := newMigrator(newProgressStore(client), dryRun, "test")
+	if err != nil {
+		t.Fatalf("newMigrator: %v", err)
+	}
+	m.migrateSkills(dump.Skills.Skills)
+	m.migrateProjects(dump.Projects.Projects)
+	return m.report
+}
+
+// actions - Item ID -> action
+func actions(report *Report) map[string]string {
+	result := make(map[string]string, len(report.Items))
+	for _, item := range report.Items {
+		result[item.ID] = item.Action
+	}
+	return result
+}
+
+func TestMigrationRuns(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	skillsRepo := models.NewSkillsRepository(client)
+	projectsRepo := models.NewProjectsRepository(client)
+
+	changed := testDump()
+	changed.Projects.Projects[0].Description = "Portfolio v2"
+	changed.Skills.Skills[1].Icon = "/skills-upload/golang.svg"
+
+	runs := []struct {
+		name   string
+		dump   *V1Dump
+		dryRun bool
+		before func() // Çalışmadan önce Redis'e yapılan değişiklik
+		want   map[string]string
+	}{
+		{
+			name:   "dry run writes nothing",
+			dump:   testDump(),
+			dryRun: true,
+			want: map[string]string{
+				"skill:Frontend:React": actionCreated, "skill:Backend:Go": actionCreated,
+				"project:Site": actionCreated, "project:API": actionCreated,
+			},
+		},
+		{
+			name: "first run creates everything",
+			dump: testDump(),
+			want: map[string]string{
+				"skill:Frontend:React": actionCreated, "skill:Backend:Go": actionCreated,
+				"project:Site": actionCreated, "project:API": actionCreated,
+			},
+		},
+		{
+			name: "rerun skips migrated items",
+			dump: testDump(),
+			want: map[string]string{
+				"skill:Frontend:React": actionSkipped, "skill:Backend:Go": actionSkipped,
+				"project:Site": actionSkipped, "project:API": actionSkipped,
+			},
+		},
+		{
+			name: "interrupted run resumes without rewriting",
+			dump: testDump(),
+			// Son item'ın hash'i kaydedilmeden önce kesilmiş gibi
+			before: func() { mr.HDel(progressHashesKey, "project:API") },
+			want: map[string]string{
+				"skill:Frontend:React": actionSkipped, "skill:Backend:Go": actionSkipped,
+				"project:Site": actionSkipped, "project:API": actionUnchanged,
+			},
+		},
+		{
+			name: "changed source items are updated",
+			dump: changed,
+			// V2'de eklenen alanlar korunmalı
+			before: func() {
+				project, _ := projectsRepo.GetProjectByID("project:Site")
+				project.Featured = true
+				project.ViewCount = 42
+				projectsRepo.UpdateProject(project)
+			},
+			want: map[string]string{
+				"skill:Frontend:React": actionSkipped, "skill:Backend:Go": actionUpdated,
+				"project:Site": actionUpdated, "project:API": actionSkipped,
+			},
+		},
+	}
+
+	for _, run := range runs {
+		t.Run(run.name, func(t *testing.T) {
+			if run.before != nil {
+				run.before()
+			}
+			report := migrate(t, client, run.dump, run.dryRun)
+
+			got := actions(report)
+			for id, want := range run.want {
+				if got[id] != want {
+					t.Errorf("%s = %s, want %s", id, got[id], want)
+				}
+			}
+			if len(got) != len(run.want) {
+				t.Errorf("report has %d items, want %d", len(got), len(run.want))
+			}
+
+			if run.dryRun {
+				if keys := mr.Keys(); len(keys) != 0 {
+					t.Errorf("dry run wrote %v", keys)
+				}
+				return
+			}
+
+			// Her çalışmadan sonra Redis kaynakla alan alan aynı olmalı
+			if result, err := validateMigration(skillsRepo, projectsRepo, models.NewProjectStatusesRepository(client), run.dump); err != nil {
+				t.Errorf("validateMigration: %v (%+v)", err, result)
+			}
+		})
+	}
+
+	project, err := projectsRepo.GetProjectByID("project:Site")
+	if err != nil {
+		t.Fatalf("GetProjectByID: %v", err)
+	}
+	if project.Description != "Portfolio v2" || project.Status != "Live" || !project.Featured || project.ViewCount != 42 {
+		t.Errorf("project after update = %+v", project)
+	}
+}
+
+func TestMigrationReportsChanges(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	migrate(t, client, testDump(), false)
+
+	changed := testDump()
+	changed.Projects.Projects[0].Tools = `[{"skill":"React","icon":"/skills-upload/react.svg"},{"skill":"Go","icon":"/skills-upload/go.svg"}]`
+	changed.Projects.Projects[0].Status = "In Progress"
+	// Dry-run da diff'i gösterir ama yazmaz
+	report := migrate(t, client, changed, true)
+
+	var changes map[string]bool
+	for _, item := range report.Items {
+		if item.ID != "project:Site" {
+			continue
+		}
+		if item.Action != actionUpdated {
+			t.Fatalf("project:Site = %s, want %s", item.Action, actionUpdated)
+		}
+		changes = make(map[string]bool)
+		for _, change := range item.Changes {
+			changes[change.Field] = true
+		}
+	}
+	// Tool'ların SkillID'leri (V2'de eklenen) diff'e girmez, sadece gerçekten değişen alanlar
+	if len(changes) != 2 || !changes["tools"] || !changes["status"] {
+		t.Errorf("changes = %v, want tools and status", changes)
+	}
+
+	project, _ := models.NewProjectsRepository(client).GetProjectByID("project:Site")
+	if project.Status != "Live" || len(project.Tools) != 1 {
+		t.Errorf("dry run changed the project: %+v", project)
+	}
+}
+
+func TestMigrationFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(*V1Dump)
+		id      string
+		written bool // Duplicate'lerde ilk kayıt yazılır, sadece tekrarı hata olur
+	}{
+		{"unknown status", func(d *V1Dump) { d.Projects.Projects[1].Status = "bogus" }, "project:API", false},
+		{"duplicate skill", func(d *V1Dump) { d.Skills.Skills = append(d.Skills.Skills, d.Skills.Skills[0]) }, "skill:Frontend:React", true},
+		{"duplicate project", func(d *V1Dump) { d.Projects.Projects = append(d.Projects.Projects, d.Projects.Projects[0]) }, "project:Site", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			defer client.Close()
+
+			dump := testDump()
+			tt.edit(dump)
+			report := migrate(t, client, dump, false)
+
+			if report.Summary[actionFailed] != 1 {
+				t.Fatalf("summary = %v, want one failed item", report.Summary)
+			}
+			for _, item := range report.Items {
+				if item.Action == actionFailed && (item.ID != tt.id || item.Error == "") {
+					t.Errorf("failed item = %+v, want %s with an error", item, tt.id)
+				}
+			}
+
+			// Hiç yazılamayan item'ın hash'i kaydedilmez, sonraki çalışma tekrar dener
+			hashes, err := newProgressStore(client).hashes()
+			if err != nil {
+				t.Fatalf("hashes: %v", err)
+			}
+			if _, ok := hashes[tt.id]; ok != tt.written {
+				t.Errorf("%s marked done: %v, want %v", tt.id, ok, tt.written)
+			}
+		})
+	}
+}
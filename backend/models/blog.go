@@ -27,6 +27,9 @@ type BlogPost struct {
 	// SEO için metadata
 	MetaDescription string `json:"meta_description,omitempty"` // SEO description
 	MetaKeywords    string `json:"meta_keywords,omitempty"`    // SEO keywords
+
+	// MD import'ta tanınmayan frontmatter key'leri (export'ta geri yazılır)
+	Extras map[string]interface{} `json:"extras,omitempty"`
 }
 
 // BlogPostSummary - Liste görünümü için hafif version
@@ -202,6 +202,62 @@ func (r *BlogRepository) UpdatePost(post *BlogPost) error {
 	return err
 }
 
+// ReplacePost - Post'u import/sync edilen hali ile değiştir (UpdatedAt dosyadan gelir, değiştirilmez)
+// JSON ve tüm index farkları (tag, published, featured, tarih, view) tek transaction'da yazılır;
+// yazma başarısız olursa mevcut post olduğu gibi kalır
+func (r *BlogRepository) ReplacePost(existing, post *BlogPost) error {
+	if existing.ID != post.ID {
+		return fmt.Errorf("cannot replace blog post %s with %s", existing.ID, post.ID)
+	}
+
+	postJSON, err := post.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal blog post: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(r.ctx, post.ID, postJSON, time.Hour*24*365)
+	pipe.SAdd(r.ctx, "blog:posts:all", post.ID)
+
+	// Tag index farkı
+	newTags := make(map[string]bool, len(post.Tags))
+	for _, tag := range post.Tags {
+		newTags[tag] = true
+		pipe.SAdd(r.ctx, "blog:tags", tag)
+		pipe.SAdd(r.ctx, fmt.Sprintf("blog:tag:%s", tag), post.ID)
+	}
+	for _, tag := range existing.Tags {
+		if !newTags[tag] {
+			pipe.SRem(r.ctx, fmt.Sprintf("blog:tag:%s", tag), post.ID)
+		}
+	}
+
+	if post.Published {
+		pipe.SAdd(r.ctx, "blog:posts:published", post.ID)
+	} else {
+		pipe.SRem(r.ctx, "blog:posts:published", post.ID)
+	}
+	if post.Featured {
+		pipe.SAdd(r.ctx, "blog:posts:featured", post.ID)
+	} else {
+		pipe.SRem(r.ctx, "blog:posts:featured", post.ID)
+	}
+
+	pipe.ZAdd(r.ctx, "blog:by_date", redis.Z{
+		Score:  float64(post.PublishedAt.Unix()),
+		Member: post.ID,
+	})
+	pipe.ZAdd(r.ctx, "blog:by_views", redis.Z{
+		Score:  float64(post.ViewCount),
+		Member: post.ID,
+	})
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to replace blog post: %w", err)
+	}
+	return nil
+}
+
 // IncrementPostViews - Post görüntüleme sayısını artır
 func (r *BlogRepository) IncrementPostViews(postID string) error {
 	post, err := r.GetPostByID(postID)
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Contact mesajı status'ları
+const (
+	ContactUnread   = "unread"
+	ContactRead     = "read"
+	ContactArchived = "archived"
+)
+
+// ContactStatuses - Geçerli status listesi (validation ve index temizliği için)
+var ContactStatuses = []string{ContactUnread, ContactRead, ContactArchived}
+
+// ContactMessage - İletişim formundan gelen mesaj
+type ContactMessage struct {
+	ID        string     `json:"id"`                // "contact:1736421022000000000"
+	Name      string     `json:"name"`              // Gönderen adı
+	Email     string     `json:"email"`             // Cevap adresi
+	Subject   string     `json:"subject,omitempty"` // Opsiyonel konu
+	Message   string     `json:"message"`           // Mesaj içeriği
+	Status    string     `json:"status"`            // unread, read, archived
+	CreatedAt time.Time  `json:"created_at"`        // Gönderim zamanı
+	ReadAt    *time.Time `json:"read_at,omitempty"` // İlk okunma zamanı
+}
+
+// ContactMessagesResponse - Admin listesi için response
+type ContactMessagesResponse struct {
+	Messages []ContactMessage `json:"messages"`
+	Total    int              `json:"total"`
+	Unread   int              `json:"unread"`
+	Page     int              `json:"page"`
+	Limit    int              `json:"limit"`
+}
+
+// NewContactMessage - Yeni unread mesaj oluşturucu
+func NewContactMessage(name, email, subject, message string) *ContactMessage {
+	now := time.Now()
+	return &ContactMessage{
+		ID:        fmt.Sprintf("contact:%d", now.UnixNano()),
+		Name:      name,
+		Email:     email,
+		Subject:   subject,
+		Message:   message,
+		Status:    ContactUnread,
+		CreatedAt: now,
+	}
+}
+
+// IsValidContactStatus - Status geçerli mi?
+func IsValidContactStatus(status string) bool {
+	for _, s := range ContactStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// SetStatus - Status değiştir, ilk okunmada ReadAt set edilir
+func (m *ContactMessage) SetStatus(status string) {
+	if status != ContactUnread && m.ReadAt == nil {
+		now := time.Now()
+		m.ReadAt = &now
+	}
+	m.Status = status
+}
+
+// ToJSON ve FromJSON methodları
+func (m *ContactMessage) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (m *ContactMessage) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), m)
+}
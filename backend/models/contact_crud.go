@@ -0,0 +1,227 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ContactRepository - İletişim mesajları için CRUD operations
+type ContactRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewContactRepository - Repository oluştur
+func NewContactRepository(client *redis.Client) *ContactRepository {
+	return &ContactRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// CreateMessage - Yeni mesaj kaydet
+func (r *ContactRepository) CreateMessage(message *ContactMessage) error {
+	messageJSON, err := message.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal contact message: %w", err)
+	}
+
+	pipe := r.client.Pipeline()
+
+	// Mesaj verisi (expire yok - admin silene kadar kalır)
+	pipe.Set(r.ctx, message.ID, messageJSON, 0)
+
+	// Date sorted set (en yeni first listeleme için)
+	pipe.ZAdd(r.ctx, "contact:messages:by_date", redis.Z{
+		Score:  float64(message.CreatedAt.UnixNano()),
+		Member: message.ID,
+	})
+
+	// Status index'i
+	pipe.SAdd(r.ctx, contactStatusKey(message.Status), message.ID)
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save contact message: %w", err)
+	}
+
+	return nil
+}
+
+// GetMessageByID - ID'ye göre mesaj getir
+func (r *ContactRepository) GetMessageByID(messageID string) (*ContactMessage, error) {
+	messageJSON, err := r.client.Get(r.ctx, messageID).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("contact message not found: %s", messageID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact message: %w", err)
+	}
+
+	var message ContactMessage
+	err = message.FromJSON(messageJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal contact message: %w", err)
+	}
+
+	return &message, nil
+}
+
+// GetMessages - Mesajları listele (en yeni first, status filtresi opsiyonel)
+func (r *ContactRepository) GetMessages(status string, page, limit int) (*ContactMessagesResponse, error) {
+	messageIDs, err := r.client.ZRevRange(r.ctx, "contact:messages:by_date", 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact message IDs: %w", err)
+	}
+
+	// Status filtresi - sıralamayı korumak için sorted set üzerinden filtrele
+	if status != "" {
+		members, err := r.client.SMembers(r.ctx, contactStatusKey(status)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get contact status index: %w", err)
+		}
+
+		inStatus := make(map[string]bool, len(members))
+		for _, id := range members {
+			inStatus[id] = true
+		}
+
+		filtered := make([]string, 0, len(members))
+		for _, id := range messageIDs {
+			if inStatus[id] {
+				filtered = append(filtered, id)
+			}
+		}
+		messageIDs = filtered
+	}
+
+	unread, err := r.client.SCard(r.ctx, contactStatusKey(ContactUnread)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+
+	// Pagination
+	total := len(messageIDs)
+	start := (page - 1) * limit
+	end := start + limit
+
+	if start >= total {
+		messageIDs = []string{}
+	} else if end > total {
+		messageIDs = messageIDs[start:]
+	} else {
+		messageIDs = messageIDs[start:end]
+	}
+
+	messages, err := r.getMessagesByIDs(messageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContactMessagesResponse{
+		Messages: messages,
+		Total:    total,
+		Unread:   int(unread),
+		Page:     page,
+		Limit:    limit,
+	}, nil
+}
+
+// UpdateStatus - Mesaj status'unu değiştir
+func (r *ContactRepository) UpdateStatus(messageID, status string) (*ContactMessage, error) {
+	message, err := r.GetMessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldStatus := message.Status
+	message.SetStatus(status)
+
+	messageJSON, err := message.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal contact message: %w", err)
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.Set(r.ctx, message.ID, messageJSON, 0)
+	pipe.SRem(r.ctx, contactStatusKey(oldStatus), message.ID)
+	pipe.SAdd(r.ctx, contactStatusKey(status), message.ID)
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update contact message: %w", err)
+	}
+
+	return message, nil
+}
+
+// DeleteMessage - Mesajı sil
+func (r *ContactRepository) DeleteMessage(messageID string) error {
+	message, err := r.GetMessageByID(messageID)
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.Del(r.ctx, message.ID)
+	pipe.ZRem(r.ctx, "contact:messages:by_date", message.ID)
+	pipe.SRem(r.ctx, contactStatusKey(message.Status), message.ID)
+
+	_, err = pipe.Exec(r.ctx)
+	return err
+}
+
+// CheckRateLimit - IP başına rate limit (window içinde en fazla limit mesaj)
+// true dönerse istek limiti aşmış demektir
+func (r *ContactRepository) CheckRateLimit(clientIP string, limit int, window time.Duration) (bool, error) {
+	key := "contact:rate:" + clientIP
+
+	// Key yoksa TTL ile oluşturulur (window başlar), INCR TTL'i korur
+	// Tek transaction'da olduğu için TTL'siz sayaç kalamaz
+	pipe := r.client.TxPipeline()
+	pipe.SetNX(r.ctx, key, 0, window)
+	incr := pipe.Incr(r.ctx, key)
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return false, fmt.Errorf("failed to check contact rate limit: %w", err)
+	}
+
+	return incr.Val() > int64(limit), nil
+}
+
+// Helper Methods
+
+// getMessagesByIDs - Bulk read helper (sırayı korur)
+func (r *ContactRepository) getMessagesByIDs(messageIDs []string) ([]ContactMessage, error) {
+	if len(messageIDs) == 0 {
+		return []ContactMessage{}, nil
+	}
+
+	messageJSONs, err := r.client.MGet(r.ctx, messageIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact messages: %w", err)
+	}
+
+	messages := make([]ContactMessage, 0, len(messageJSONs))
+	for i, messageJSON := range messageJSONs {
+		if messageJSON == nil {
+			continue
+		}
+
+		var message ContactMessage
+		err = message.FromJSON(messageJSON.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal contact message %s: %w", messageIDs[i], err)
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func contactStatusKey(status string) string {
+	return fmt.Sprintf("contact:messages:status:%s", status)
+}
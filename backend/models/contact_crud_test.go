@@ -0,0 +1,85 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCheckRateLimit(t *testing.T) {
+	const (
+		limit  = 3
+		window = time.Hour
+	)
+
+	// step - Bir istek (veya süre ilerletme) ve beklenen sonuç
+	type step struct {
+		ip      string
+		advance time.Duration // İstekten önce geçen süre
+		limited bool
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "allows up to the limit",
+			steps: []step{
+				{ip: "1.1.1.1"}, {ip: "1.1.1.1"}, {ip: "1.1.1.1"},
+				{ip: "1.1.1.1", limited: true},
+				{ip: "1.1.1.1", limited: true},
+			},
+		},
+		{
+			name: "counts each IP separately",
+			steps: []step{
+				{ip: "1.1.1.1"}, {ip: "1.1.1.1"}, {ip: "1.1.1.1"},
+				{ip: "2.2.2.2"},
+				{ip: "1.1.1.1", limited: true},
+			},
+		},
+		{
+			name: "resets after the window",
+			steps: []step{
+				{ip: "1.1.1.1"}, {ip: "1.1.1.1"}, {ip: "1.1.1.1"},
+				{ip: "1.1.1.1", limited: true},
+				{ip: "1.1.1.1", advance: window},
+				{ip: "1.1.1.1"}, {ip: "1.1.1.1"},
+				{ip: "1.1.1.1", limited: true},
+			},
+		},
+		{
+			name: "window starts at the first request",
+			steps: []step{
+				{ip: "1.1.1.1"},
+				{ip: "1.1.1.1", advance: window / 2},
+				{ip: "1.1.1.1"},
+				{ip: "1.1.1.1", advance: window / 2}, // İlk istekten bir window sonra sayaç sıfırlanır
+				{ip: "1.1.1.1"}, {ip: "1.1.1.1"},
+				{ip: "1.1.1.1", limited: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr, client := newTestRedis(t)
+			repo := NewContactRepository(client)
+
+			for i, s := range tt.steps {
+				mr.FastForward(s.advance)
+				limited, err := repo.CheckRateLimit(s.ip, limit, window)
+				if err != nil {
+					t.Fatalf("step %d: CheckRateLimit: %v", i, err)
+				}
+				if limited != s.limited {
+					t.Errorf("step %d (%s): limited = %v, want %v", i, s.ip, limited, s.limited)
+				}
+				// Sayaç TTL'siz kalmamalı, yoksa IP sonsuza kadar engellenir
+				if ttl := mr.TTL("contact:rate:" + s.ip); ttl <= 0 || ttl > window {
+					t.Errorf("step %d: TTL = %v, want (0, %v]", i, ttl, window)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SyncedFile - Content dizinindeki bir dosyanın son başarılı sync bilgisi
+// Hash aynıysa ve entity Redis'te duruyorsa dosya tekrar yazılmaz
+type SyncedFile struct {
+	Type     string    `json:"type"` // post, project, skill
+	ID       string    `json:"id"`
+	Hash     string    `json:"hash"` // sha256 (hex)
+	SyncedAt time.Time `json:"synced_at"`
+}
+
+// ContentSyncItem - Tek dosyanın (veya silinen entity'nin) sync sonucu
+type ContentSyncItem struct {
+	Type   string `json:"type"`
+	Path   string `json:"path"`
+	ID     string `json:"id,omitempty"`
+	Action string `json:"action"` // created, updated, unchanged, deleted, failed
+	Error  string `json:"error,omitempty"`
+}
+
+// ContentSyncStatus - Son sync çalışmasının özeti
+type ContentSyncStatus struct {
+	Dir        string            `json:"dir"`
+	Trigger    string            `json:"trigger"` // startup, watch, manual
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt time.Time         `json:"finished_at"`
+	Duration   string            `json:"duration"`
+	Summary    map[string]int    `json:"summary"`
+	Errors     []ContentSyncItem `json:"errors"`  // Dosya bazlı hatalar
+	Changes    []ContentSyncItem `json:"changes"` // unchanged dışındaki sonuçlar
+}
+
+// ToJSON - JSON string'e çevir
+func (f *SyncedFile) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(f)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// ToJSON - JSON string'e çevir
+func (s *ContentSyncStatus) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// FromJSON - JSON string'den status oluştur
+func (s *ContentSyncStatus) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), s)
+}
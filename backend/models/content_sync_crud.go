@@ -0,0 +1,98 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Content sync key'leri
+const (
+	contentSyncFilesKey  = "content_sync:files"  // Hash: relative path -> SyncedFile JSON
+	contentSyncStatusKey = "content_sync:status" // Son çalışmanın ContentSyncStatus JSON'u
+)
+
+// ContentSyncRepository - Content dizini sync state'i
+type ContentSyncRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewContentSyncRepository - Repository oluştur
+func NewContentSyncRepository(client *redis.Client) *ContentSyncRepository {
+	return &ContentSyncRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// GetFiles - Takip edilen tüm dosyalar (path -> SyncedFile)
+func (r *ContentSyncRepository) GetFiles() (map[string]SyncedFile, error) {
+	values, err := r.client.HGetAll(r.ctx, contentSyncFilesKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get synced files: %w", err)
+	}
+
+	files := make(map[string]SyncedFile, len(values))
+	for path, value := range values {
+		var file SyncedFile
+		if err := json.Unmarshal([]byte(value), &file); err != nil {
+			fmt.Printf("Warning: Failed to parse synced file %s: %v\n", path, err)
+			continue
+		}
+		files[path] = file
+	}
+
+	return files, nil
+}
+
+// ReplaceFiles - Takip listesini tek seferde yenisiyle değiştir
+func (r *ContentSyncRepository) ReplaceFiles(files map[string]SyncedFile) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, contentSyncFilesKey)
+
+	for path, file := range files {
+		fileJSON, err := file.ToJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal synced file: %w", err)
+		}
+		pipe.HSet(r.ctx, contentSyncFilesKey, path, fileJSON)
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to save synced files: %w", err)
+	}
+	return nil
+}
+
+// SaveStatus - Son çalışmanın özetini kaydet
+func (r *ContentSyncRepository) SaveStatus(status *ContentSyncStatus) error {
+	statusJSON, err := status.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal sync status: %w", err)
+	}
+
+	if err := r.client.Set(r.ctx, contentSyncStatusKey, statusJSON, 0).Err(); err != nil {
+		return fmt.Errorf("failed to save sync status: %w", err)
+	}
+	return nil
+}
+
+// GetStatus - Son çalışmanın özeti
+func (r *ContentSyncRepository) GetStatus() (*ContentSyncStatus, error) {
+	statusJSON, err := r.client.Get(r.ctx, contentSyncStatusKey).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("sync status not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync status: %w", err)
+	}
+
+	var status ContentSyncStatus
+	if err := status.FromJSON(statusJSON); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sync status: %w", err)
+	}
+	return &status, nil
+}
@@ -2,32 +2,72 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
+
+	"portfolio-backend/markdown"
 )
 
+// slugPattern - "case-study-2024"
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
 // ProjectTool - Projede kullanılan teknoloji/tool
 // V1'de tools array içindeki her element için
+// SkillID varsa Skill ve Icon okuma sırasında skill'den doldurulur (V1 format'ı korunur)
 type ProjectTool struct {
-	Skill string `json:"skill"` // "React", "TailwindCSS" vs
-	Icon  string `json:"icon"`  // Local uploads or external URL
+	SkillID string `json:"skill_id,omitempty" yaml:"skill_id,omitempty"` // "skill:Frontend:React"
+	Skill   string `json:"skill" yaml:"skill"`                           // "React", "TailwindCSS" vs
+	Icon    string `json:"icon" yaml:"icon"`                             // Local uploads or external URL
+}
+
+// ProjectImage - Proje galerisindeki tek görsel
+// Dosyalar uploads/gallery/{proje}/ altında, Width/Height upload sırasında okunur (bilinmiyorsa 0)
+type ProjectImage struct {
+	ID      string `json:"id" yaml:"id"`
+	URL     string `json:"url" yaml:"url"`
+	Alt     string `json:"alt" yaml:"alt"`
+	Caption string `json:"caption,omitempty" yaml:"caption,omitempty"`
+	Width   int    `json:"width,omitempty" yaml:"width,omitempty"`
+	Height  int    `json:"height,omitempty" yaml:"height,omitempty"`
+}
+
+// ProjectTimeframe - Projenin çalışıldığı dönem ("2023-01" veya "2023-01-15")
+// End boşsa devam ediyor
+type ProjectTimeframe struct {
+	Start string `json:"start" yaml:"start"`
+	End   string `json:"end,omitempty" yaml:"end,omitempty"`
 }
 
 // Project struct - Tek bir proje için veri modeli
 // V1 API format'ına tam uygun
 type Project struct {
-	ID          string        `json:"id"`                    // MongoDB ObjectID'den geldi
-	Title       string        `json:"title"`                 // "404 Squad"
-	Description string        `json:"description"`           // Açıklama
-	Tools       []ProjectTool `json:"tools"`                 // Kullanılan teknolojiler
-	Link        string        `json:"link"`                  // Live URL veya GitHub
-	Image       string        `json:"image"`                 // Proje görseli (local uploads)
-	Status      string        `json:"status"`                // "Live", "Github", "In Progress"
-	CreatedAt   string        `json:"createdAt,omitempty"`   // V1'den gelen format
-	
+	ID          string        `json:"id" yaml:"id"`                                   // MongoDB ObjectID'den geldi
+	Title       string        `json:"title" yaml:"title"`                             // "404 Squad"
+	Description string        `json:"description" yaml:"description"`                 // Açıklama
+	Tools       []ProjectTool `json:"tools" yaml:"tools"`                             // Kullanılan teknolojiler
+	Link        string        `json:"link" yaml:"link"`                               // Live URL veya GitHub
+	Image       string        `json:"image" yaml:"image"`                             // Proje görseli (local uploads)
+	Status      string        `json:"status" yaml:"status"`                           // ProjectStatus key'i: "Live", "Github", "In Progress"
+	CreatedAt   string        `json:"createdAt,omitempty" yaml:"createdAt,omitempty"` // V1'den gelen format
+
 	// V2'de ekleyebileceğimiz alanlar
-	ViewCount   int       `json:"view_count,omitempty"`   // Kaç kez görüntülendi  
-	Featured    bool      `json:"featured,omitempty"`     // Öne çıkarılsın mı
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`   // Son güncelleme
+	ViewCount int            `json:"view_count,omitempty" yaml:"view_count,omitempty"` // Kaç kez görüntülendi
+	Featured  bool           `json:"featured,omitempty" yaml:"featured,omitempty"`     // Öne çıkarılsın mı
+	Pinned    bool           `json:"pinned,omitempty" yaml:"pinned,omitempty"`         // Her sıralamada en üstte
+	Gallery   []ProjectImage `json:"gallery,omitempty" yaml:"gallery,omitempty"`       // Sıralı galeri, Image cover olarak kalır (V1)
+
+	// Case study sayfası (/works/{slug})
+	Slug        string            `json:"slug,omitempty" yaml:"slug,omitempty"`                 // URL-safe, ID'deki boşluklar yerine
+	Body        string            `json:"body,omitempty" yaml:"body,omitempty"`                 // Markdown: problem, rol, sonuç, metrikler
+	ReadingTime string            `json:"reading_time,omitempty" yaml:"reading_time,omitempty"` // Body'den hesaplanır
+	Role        string            `json:"role,omitempty" yaml:"role,omitempty"`                 // "Lead Frontend Developer"
+	Timeframe   *ProjectTimeframe `json:"timeframe,omitempty" yaml:"timeframe,omitempty"`
+	RepoURL     string            `json:"repo_url,omitempty" yaml:"repo_url,omitempty"`
+	LiveURL     string            `json:"live_url,omitempty" yaml:"live_url,omitempty"`
+	UpdatedAt   time.Time         `json:"updated_at,omitempty" yaml:"updated_at,omitempty"` // Son güncelleme
 }
 
 // ProjectsResponse - API response'u için
@@ -37,11 +77,17 @@ type ProjectsResponse struct {
 	Projects []Project `json:"projects"` // Projeler listesi
 }
 
-// ProjectsByStatus - Status'a göre gruplu response
-type ProjectsByStatus struct {
-	Live       []Project `json:"live"`        // Canlı projeler
-	GitHub     []Project `json:"github"`      // GitHub'daki projeler  
-	InProgress []Project `json:"in_progress"` // Devam eden projeler
+// Project list sıralamaları (GET /projects?sort=)
+// Pinned projeler her sıralamada en üstte kalır
+const (
+	ProjectSortManual  = "manual"  // Admin'in belirlediği position (projects:position)
+	ProjectSortLatest  = "latest"  // CreatedAt, en yeni önce (default)
+	ProjectSortPopular = "popular" // ViewCount, en çok görüntülenen önce
+)
+
+// IsValidProjectSort - Desteklenen sıralama mı?
+func IsValidProjectSort(sortBy string) bool {
+	return sortBy == ProjectSortManual || sortBy == ProjectSortLatest || sortBy == ProjectSortPopular
 }
 
 // NewProject - Yeni proje oluşturucu
@@ -61,6 +107,18 @@ func NewProject(title, description, link, image, status string, tools []ProjectT
 	}
 }
 
+// NewProjectImage - Galeri görseli oluşturucu
+func NewProjectImage(url, alt, caption string, width, height int) ProjectImage {
+	return ProjectImage{
+		ID:      randomHex(6),
+		URL:     url,
+		Alt:     alt,
+		Caption: caption,
+		Width:   width,
+		Height:  height,
+	}
+}
+
 // generateProjectID - Proje için unique ID oluştur
 // Redis key format: "project:404-squad"
 func generateProjectID(title string) string {
@@ -126,4 +184,150 @@ func (p *Project) HasTool(toolName string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+
+// PrepareBody - Body'yi blog yazıları gibi analiz et (image path'leri ve okuma süresi)
+// Slug yoksa title'dan üretilir, Link boşsa live/repo linkinden doldurulur (V1 client'lar için)
+func (p *Project) PrepareBody() {
+	if p.Slug == "" {
+		p.Slug = markdown.Slug(p.Title)
+	}
+	p.ReadingTime = ""
+	if strings.TrimSpace(p.Body) != "" {
+		p.Body = markdown.ResolveImagePaths(p.Body)
+		p.ReadingTime = markdown.ReadingTime(p.Body)
+	}
+	if p.Link == "" {
+		if p.LiveURL != "" {
+			p.Link = p.LiveURL
+		} else {
+			p.Link = p.RepoURL
+		}
+	}
+}
+
+// GalleryImage - ID'ye göre galeri görseli
+func (p *Project) GalleryImage(imageID string) (*ProjectImage, error) {
+	for i := range p.Gallery {
+		if p.Gallery[i].ID == imageID {
+			return &p.Gallery[i], nil
+		}
+	}
+	return nil, fmt.Errorf("gallery image not found: %s", imageID)
+}
+
+// RemoveGalleryImage - Görseli galeriden çıkar
+// Cover bu görselse galerideki bir sonraki görsel cover olur
+func (p *Project) RemoveGalleryImage(imageID string) (*ProjectImage, error) {
+	for i, image := range p.Gallery {
+		if image.ID != imageID {
+			continue
+		}
+		p.Gallery = append(p.Gallery[:i], p.Gallery[i+1:]...)
+		if p.Image == image.URL {
+			p.Image = ""
+			if len(p.Gallery) > 0 {
+				p.Image = p.Gallery[0].URL
+			}
+		}
+		return &image, nil
+	}
+	return nil, fmt.Errorf("gallery image not found: %s", imageID)
+}
+
+// ReorderGallery - Galeriyi verilen ID sırasına göre diz
+// Liste tüm görselleri tam olarak bir kez içermeli
+func (p *Project) ReorderGallery(imageIDs []string) error {
+	if len(imageIDs) != len(p.Gallery) {
+		return fmt.Errorf("order must contain all %d gallery images", len(p.Gallery))
+	}
+
+	ordered := make([]ProjectImage, 0, len(imageIDs))
+	seen := make(map[string]bool, len(imageIDs))
+	for _, id := range imageIDs {
+		if seen[id] {
+			return fmt.Errorf("duplicate gallery image in order: %s", id)
+		}
+		seen[id] = true
+
+		image, err := p.GalleryImage(id)
+		if err != nil {
+			return err
+		}
+		ordered = append(ordered, *image)
+	}
+
+	p.Gallery = ordered
+	return nil
+}
+
+// Validate - Zorunlu alanlar ve ID/link formatı (bulk import için)
+func (p *Project) Validate() error {
+	if strings.TrimSpace(p.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if strings.TrimSpace(p.Status) == "" {
+		return fmt.Errorf("status is required")
+	}
+	// Skill ve post key'leri ile çakışmasın
+	if strings.HasPrefix(p.ID, SkillKeyPrefix) || strings.HasPrefix(p.ID, "blog:") {
+		return fmt.Errorf("invalid project id: %s", p.ID)
+	}
+	if p.Link != "" && !isAbsoluteURL(p.Link) {
+		return fmt.Errorf("invalid link: %s", p.Link)
+	}
+	for i, tool := range p.Tools {
+		if strings.TrimSpace(tool.Skill) == "" {
+			return fmt.Errorf("tools[%d]: skill is required", i)
+		}
+	}
+	for i, image := range p.Gallery {
+		if strings.TrimSpace(image.URL) == "" {
+			return fmt.Errorf("gallery[%d]: url is required", i)
+		}
+	}
+	return p.ValidateCaseStudy()
+}
+
+// ValidateCaseStudy - Slug, repo/live linkleri ve timeframe formatı
+// Create/Update handler'ları sadece bunu kontrol eder (V1 link'leri serbest kalır)
+func (p *Project) ValidateCaseStudy() error {
+	if p.Slug != "" && !slugPattern.MatchString(p.Slug) {
+		return fmt.Errorf("invalid slug: %s (lowercase letters, digits and dashes)", p.Slug)
+	}
+	if p.RepoURL != "" && !isAbsoluteURL(p.RepoURL) {
+		return fmt.Errorf("invalid repo_url: %s", p.RepoURL)
+	}
+	if p.LiveURL != "" && !isAbsoluteURL(p.LiveURL) {
+		return fmt.Errorf("invalid live_url: %s", p.LiveURL)
+	}
+	if p.Timeframe != nil {
+		start, err := parseTimeframeDate(p.Timeframe.Start)
+		if err != nil || p.Timeframe.Start == "" {
+			return fmt.Errorf("invalid timeframe start: %q (YYYY-MM or YYYY-MM-DD)", p.Timeframe.Start)
+		}
+		if p.Timeframe.End != "" {
+			end, err := parseTimeframeDate(p.Timeframe.End)
+			if err != nil {
+				return fmt.Errorf("invalid timeframe end: %q (YYYY-MM or YYYY-MM-DD)", p.Timeframe.End)
+			}
+			if end.Before(start) {
+				return fmt.Errorf("timeframe end is before start")
+			}
+		}
+	}
+	return nil
+}
+
+func isAbsoluteURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && parsed.Scheme != "" && parsed.Host != ""
+}
+
+// parseTimeframeDate - "2023-01" veya "2023-01-15"
+func parseTimeframeDate(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01", value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// colorPattern - "#fff" veya "#22c55e"
+var colorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// ProjectStatus - Admin'in tanımladığı proje durumu
+// Key projede saklanan değerdir ("projects:status:{key}" index'i), sonradan değiştirilemez
+type ProjectStatus struct {
+	Key       string    `json:"key"`   // "Live"
+	Label     string    `json:"label"` // "Canlı"
+	Color     string    `json:"color"` // "#22c55e"
+	Order     int       `json:"order"` // Gruplu listede sıra (küçük önce)
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ProjectStatusGroup - Status ve o status'taki projeler (GET /projects/grouped)
+type ProjectStatusGroup struct {
+	ProjectStatus
+	Count    int       `json:"count"`
+	Projects []Project `json:"projects"`
+}
+
+// DefaultProjectStatuses - Hiç status tanımlanmamışsa kullanılan V1 status'ları
+func DefaultProjectStatuses() []ProjectStatus {
+	now := time.Now()
+	return []ProjectStatus{
+		{Key: "Live", Label: "Live", Color: "#22c55e", Order: 1, CreatedAt: now, UpdatedAt: now},
+		{Key: "Github", Label: "GitHub", Color: "#6b7280", Order: 2, CreatedAt: now, UpdatedAt: now},
+		{Key: "In Progress", Label: "In Progress", Color: "#f59e0b", Order: 3, CreatedAt: now, UpdatedAt: now},
+	}
+}
+
+// NewProjectStatus - Yeni status oluşturucu
+func NewProjectStatus(key, label, color string, order int) *ProjectStatus {
+	key = strings.TrimSpace(key)
+	if strings.TrimSpace(label) == "" {
+		label = key
+	}
+	return &ProjectStatus{
+		Key:       key,
+		Label:     strings.TrimSpace(label),
+		Color:     strings.TrimSpace(color),
+		Order:     order,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Validate - Key, label ve renk formatı
+func (s *ProjectStatus) Validate() error {
+	if s.Key == "" {
+		return fmt.Errorf("key is required")
+	}
+	if strings.Contains(s.Key, ":") {
+		return fmt.Errorf("key must not contain ':': %s", s.Key)
+	}
+	if s.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+	if s.Color != "" && !colorPattern.MatchString(s.Color) {
+		return fmt.Errorf("invalid color: %s (expected #rgb or #rrggbb)", s.Color)
+	}
+	return nil
+}
+
+// MatchProjectStatus - Değeri tanımlı bir status'a eşle
+// Büyük/küçük harf, boşluk, "-" ve "_" farkı yok sayılır; key ve label ile eşleşir
+// ("live", "in-progress", "GitHub" -> "Live", "In Progress", "Github")
+func MatchProjectStatus(statuses []ProjectStatus, value string) *ProjectStatus {
+	for i := range statuses {
+		if statuses[i].Key == value {
+			return &statuses[i]
+		}
+	}
+
+	normalized := normalizeStatusName(value)
+	if normalized == "" {
+		return nil
+	}
+	for i := range statuses {
+		if normalizeStatusName(statuses[i].Key) == normalized || normalizeStatusName(statuses[i].Label) == normalized {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+func normalizeStatusName(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(value)))
+}
+
+// sortProjectStatuses - Order, sonra key
+func sortProjectStatuses(statuses []ProjectStatus) {
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].Order != statuses[j].Order {
+			return statuses[i].Order < statuses[j].Order
+		}
+		return statuses[i].Key < statuses[j].Key
+	})
+}
+
+// ToJSON ve FromJSON methodları
+func (s *ProjectStatus) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (s *ProjectStatus) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), s)
+}
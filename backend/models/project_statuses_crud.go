@@ -0,0 +1,284 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"portfolio-backend/events"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// projectStatusesKey - Key -> ProjectStatus JSON hash'i
+// ("projects:status:*" index'leri ile karışmasın diye ayrı prefix)
+const projectStatusesKey = "project_statuses"
+
+var (
+	// ErrUnknownStatus - Proje status'u tanımlı status'lardan biri değil
+	ErrUnknownStatus = errors.New("unknown project status")
+	// ErrStatusInUse - Silinmek istenen status'ta hâlâ proje var
+	ErrStatusInUse = errors.New("project status in use")
+)
+
+// ProjectStatusesRepository - Proje status tanımları için CRUD operations
+type ProjectStatusesRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewProjectStatusesRepository - Repository oluştur
+func NewProjectStatusesRepository(client *redis.Client) *ProjectStatusesRepository {
+	return &ProjectStatusesRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// GetAllStatuses - Tanımlı status'lar (order'a göre sıralı)
+// Henüz hiç tanımlanmamışsa V1 status'ları döner
+func (r *ProjectStatusesRepository) GetAllStatuses() ([]ProjectStatus, error) {
+	statusJSONs, err := r.client.HGetAll(r.ctx, projectStatusesKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project statuses: %w", err)
+	}
+	if len(statusJSONs) == 0 {
+		return DefaultProjectStatuses(), nil
+	}
+
+	statuses := make([]ProjectStatus, 0, len(statusJSONs))
+	for key, statusJSON := range statusJSONs {
+		var status ProjectStatus
+		if err := status.FromJSON(statusJSON); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal project status %s: %w", key, err)
+		}
+		statuses = append(statuses, status)
+	}
+
+	sortProjectStatuses(statuses)
+	return statuses, nil
+}
+
+// GetStatus - Key'e göre status
+func (r *ProjectStatusesRepository) GetStatus(key string) (*ProjectStatus, error) {
+	statuses, err := r.GetAllStatuses()
+	if err != nil {
+		return nil, err
+	}
+	for i := range statuses {
+		if statuses[i].Key == key {
+			return &statuses[i], nil
+		}
+	}
+	return nil, fmt.Errorf("project status not found: %s", key)
+}
+
+// ResolveStatus - Gelen değeri tanımlı status'un key'ine çevir ("live" -> "Live")
+// Eşleşme yoksa ErrUnknownStatus döner
+func (r *ProjectStatusesRepository) ResolveStatus(value string) (string, error) {
+	statuses, err := r.GetAllStatuses()
+	if err != nil {
+		return "", err
+	}
+
+	status := MatchProjectStatus(statuses, value)
+	if status == nil {
+		keys := make([]string, len(statuses))
+		for i, status := range statuses {
+			keys[i] = status.Key
+		}
+		return "", fmt.Errorf("%w: %q (allowed: %s)", ErrUnknownStatus, value, strings.Join(keys, ", "))
+	}
+	return status.Key, nil
+}
+
+// SaveStatus - Status ekle veya güncelle (aynı key varsa üzerine yazılır)
+// İlk kayıtta V1 status'ları da yazılır, böylece default'lar kaybolmaz
+func (r *ProjectStatusesRepository) SaveStatus(status *ProjectStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+	if err := r.ensureDefaults(); err != nil {
+		return err
+	}
+
+	status.UpdatedAt = time.Now()
+	statusJSON, err := status.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal project status: %w", err)
+	}
+
+	if err := r.client.HSet(r.ctx, projectStatusesKey, status.Key, statusJSON).Err(); err != nil {
+		return fmt.Errorf("failed to save project status: %w", err)
+	}
+	return nil
+}
+
+// DeleteStatus - Status sil
+// Projesi olan status silinemez (ErrStatusInUse), önce projeler taşınmalı
+func (r *ProjectStatusesRepository) DeleteStatus(key string) error {
+	if err := r.ensureDefaults(); err != nil {
+		return err
+	}
+
+	count, err := r.client.SCard(r.ctx, fmt.Sprintf("projects:status:%s", key)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to count status projects: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %s has %d projects", ErrStatusInUse, key, count)
+	}
+
+	total, err := r.client.HLen(r.ctx, projectStatusesKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to count project statuses: %w", err)
+	}
+	if total <= 1 {
+		return fmt.Errorf("cannot delete the last project status: %s", key)
+	}
+
+	deleted, err := r.client.HDel(r.ctx, projectStatusesKey, key).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete project status: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("project status not found: %s", key)
+	}
+	return nil
+}
+
+// ensureDefaults - Hash boşsa V1 status'larını yaz
+func (r *ProjectStatusesRepository) ensureDefaults() error {
+	exists, err := r.client.Exists(r.ctx, projectStatusesKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check project statuses: %w", err)
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	pipe := r.client.Pipeline()
+	for _, status := range DefaultProjectStatuses() {
+		statusJSON, err := status.ToJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal project status: %w", err)
+		}
+		pipe.HSetNX(r.ctx, projectStatusesKey, status.Key, statusJSON)
+	}
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to save default project statuses: %w", err)
+	}
+	return nil
+}
+
+// StatusChange - Normalize edilen proje status'u
+type StatusChange struct {
+	ProjectID string `json:"project_id"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+}
+
+// UnknownStatus - Hiçbir tanımlı status'a eşlenemeyen değer
+type UnknownStatus struct {
+	Status     string   `json:"status"`
+	ProjectIDs []string `json:"project_ids"`
+}
+
+// StatusNormalizeReport - Status migration sonucu
+type StatusNormalizeReport struct {
+	DryRun    bool            `json:"dry_run"`
+	Projects  int             `json:"projects"`
+	Unchanged int             `json:"unchanged"`
+	Changed   []StatusChange  `json:"changed"`
+	Unknown   []UnknownStatus `json:"unknown"` // Önce status tanımlanıp tekrar çalıştırılmalı
+}
+
+// NormalizeStatuses - Proje status'larını tanımlı key'lere çevir ("live" -> "Live")
+// Eşleşmeyenler değişmeden kalır ve raporda döner; dryRun'da hiçbir şey yazılmaz
+func (r *ProjectsRepository) NormalizeStatuses(statuses []ProjectStatus, dryRun bool) (*StatusNormalizeReport, error) {
+	projects, err := r.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+	sortProjectsByDate(projects)
+
+	report := &StatusNormalizeReport{
+		DryRun:   dryRun,
+		Projects: len(projects),
+		Changed:  []StatusChange{},
+		Unknown:  []UnknownStatus{},
+	}
+	unknown := make(map[string]int) // status -> report.Unknown index
+
+	for i := range projects {
+		project := &projects[i]
+		status := MatchProjectStatus(statuses, project.Status)
+		if status == nil {
+			index, ok := unknown[project.Status]
+			if !ok {
+				index = len(report.Unknown)
+				unknown[project.Status] = index
+				report.Unknown = append(report.Unknown, UnknownStatus{Status: project.Status})
+			}
+			report.Unknown[index].ProjectIDs = append(report.Unknown[index].ProjectIDs, project.ID)
+			continue
+		}
+		if status.Key == project.Status {
+			report.Unchanged++
+			continue
+		}
+
+		report.Changed = append(report.Changed, StatusChange{ProjectID: project.ID, From: project.Status, To: status.Key})
+		if dryRun {
+			continue
+		}
+		project.Status = status.Key
+		if err := r.saveProject(project, false); err != nil {
+			return report, fmt.Errorf("failed to update project %s: %w", project.ID, err)
+		}
+		events.Publish(events.ProjectUpdated, project)
+	}
+
+	// Boşalan "projects:status:live" gibi index'leri temizle
+	if len(report.Changed) > 0 && !dryRun {
+		if err := r.RebuildIndexes(); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// GetGroupedProjects - Her tanımlı status ve projeleri (boş olanlar dahil)
+// Tanımlı olmayan status'taki projeler en sonda, key'i label olarak ayrı grupta döner
+func (r *ProjectsRepository) GetGroupedProjects(statuses []ProjectStatus) ([]ProjectStatusGroup, error) {
+	projects, err := r.GetSortedProjects(ProjectSortManual)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]ProjectStatusGroup, 0, len(statuses))
+	index := make(map[string]int, len(statuses))
+	for _, status := range statuses {
+		index[status.Key] = len(groups)
+		groups = append(groups, ProjectStatusGroup{ProjectStatus: status, Projects: []Project{}})
+	}
+
+	for _, project := range projects {
+		i, ok := index[project.Status]
+		if !ok {
+			i = len(groups)
+			index[project.Status] = i
+			groups = append(groups, ProjectStatusGroup{
+				ProjectStatus: ProjectStatus{Key: project.Status, Label: project.Status, Order: len(groups) + 1},
+				Projects:      []Project{},
+			})
+		}
+		groups[i].Projects = append(groups[i].Projects, project)
+		groups[i].Count++
+	}
+
+	return groups, nil
+}
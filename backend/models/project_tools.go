@@ -0,0 +1,255 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"portfolio-backend/events"
+)
+
+// Skill -> projeler reverse index'i: "skills:projects:skill:Frontend:React"
+const skillProjectsKeyPrefix = "skills:projects:"
+
+func skillProjectsKey(skillID string) string {
+	return skillProjectsKeyPrefix + skillID
+}
+
+// UnmatchedTool - Hiçbir skill'e bağlanamayan proje tool'u
+type UnmatchedTool struct {
+	ProjectID string `json:"project_id"`
+	Skill     string `json:"skill"`
+	Icon      string `json:"icon,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+// ToolLinkReport - Tool -> skill migration sonucu
+type ToolLinkReport struct {
+	DryRun        bool            `json:"dry_run"`
+	Projects      int             `json:"projects"`
+	Tools         int             `json:"tools"`
+	Linked        int             `json:"linked"`         // Bu çalışmada bağlanan
+	AlreadyLinked int             `json:"already_linked"` // Zaten geçerli SkillID'si olan
+	Unmatched     []UnmatchedTool `json:"unmatched"`
+}
+
+// skillMatcher - Tool isim/icon'undan skill bulur
+type skillMatcher struct {
+	byID   map[string]Skill
+	byName map[string][]Skill
+	byIcon map[string][]Skill
+}
+
+func newSkillMatcher(skills []Skill) *skillMatcher {
+	matcher := &skillMatcher{
+		byID:   make(map[string]Skill, len(skills)),
+		byName: make(map[string][]Skill),
+		byIcon: make(map[string][]Skill),
+	}
+	for _, skill := range skills {
+		matcher.byID[skill.ID] = skill
+		name := normalizeToolName(skill.Skill)
+		matcher.byName[name] = append(matcher.byName[name], skill)
+		if skill.Icon != "" {
+			matcher.byIcon[skill.Icon] = append(matcher.byIcon[skill.Icon], skill)
+		}
+	}
+	return matcher
+}
+
+// match - Önce isim (büyük/küçük harf duyarsız), sonra icon URL'i
+// Aynı isimde birden fazla skill varsa icon'u eşleşen seçilir, yoksa belirsiz sayılır
+func (m *skillMatcher) match(tool ProjectTool) (*Skill, string) {
+	candidates := m.byName[normalizeToolName(tool.Skill)]
+	if len(candidates) == 1 {
+		return &candidates[0], ""
+	}
+	if len(candidates) > 1 {
+		for i := range candidates {
+			if tool.Icon != "" && candidates[i].Icon == tool.Icon {
+				return &candidates[i], ""
+			}
+		}
+		return nil, fmt.Sprintf("ambiguous: %d skills named %s", len(candidates), tool.Skill)
+	}
+
+	if byIcon := m.byIcon[tool.Icon]; tool.Icon != "" && len(byIcon) == 1 {
+		return &byIcon[0], ""
+	}
+	return nil, "no skill with this name or icon"
+}
+
+func normalizeToolName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// LinkTools - SkillID'si olmayan tool'ları isim/icon ile skill'e bağla
+// Eşleşmeyenler değişmeden kalır ve döndürülür
+// Tüm tool'lar zaten bağlıysa skill'ler okunmaz
+func (r *ProjectsRepository) LinkTools(project *Project) ([]UnmatchedTool, error) {
+	needsLink := false
+	for _, tool := range project.Tools {
+		if tool.SkillID == "" {
+			needsLink = true
+			break
+		}
+	}
+	if !needsLink {
+		return nil, nil
+	}
+
+	matcher, err := r.skillMatcher()
+	if err != nil {
+		return nil, err
+	}
+	unmatched, _, _ := linkProjectTools(project, matcher)
+	return unmatched, nil
+}
+
+// LinkAllTools - Tüm projelerdeki tool'ları skill'lere bağla (mevcut veri migration'ı)
+// dryRun'da hiçbir şey yazılmaz, sadece rapor döner
+func (r *ProjectsRepository) LinkAllTools(dryRun bool) (*ToolLinkReport, error) {
+	projects, err := r.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+	matcher, err := r.skillMatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ToolLinkReport{DryRun: dryRun, Projects: len(projects), Unmatched: []UnmatchedTool{}}
+	for i := range projects {
+		project := &projects[i]
+		unmatched, linked, alreadyLinked := linkProjectTools(project, matcher)
+
+		report.Tools += len(project.Tools)
+		report.Linked += linked
+		report.AlreadyLinked += alreadyLinked
+		report.Unmatched = append(report.Unmatched, unmatched...)
+
+		if linked == 0 || dryRun {
+			continue
+		}
+		if err := r.UpdateProject(project); err != nil {
+			return report, fmt.Errorf("failed to update project %s: %w", project.ID, err)
+		}
+		events.Publish(events.ProjectUpdated, project)
+	}
+
+	return report, nil
+}
+
+// GetProjectsBySkill - Skill'i kullanan projeler (en yeni önce)
+func (r *ProjectsRepository) GetProjectsBySkill(skillID string) ([]Project, error) {
+	projectIDs, err := r.client.SMembers(r.ctx, skillProjectsKey(skillID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill projects: %w", err)
+	}
+
+	projects, err := r.getProjectsByIDs(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	sortProjectsByDate(projects)
+	return projects, nil
+}
+
+// linkProjectTools - Geçersiz/boş SkillID'leri eşleştir
+// Dönüş: eşleşmeyenler, yeni bağlanan sayısı, zaten bağlı olan sayısı
+func linkProjectTools(project *Project, matcher *skillMatcher) ([]UnmatchedTool, int, int) {
+	var unmatched []UnmatchedTool
+	linked, alreadyLinked := 0, 0
+
+	for i := range project.Tools {
+		tool := &project.Tools[i]
+		if _, ok := matcher.byID[tool.SkillID]; ok {
+			alreadyLinked++
+			continue
+		}
+
+		skill, reason := matcher.match(*tool)
+		if skill == nil {
+			unmatched = append(unmatched, UnmatchedTool{
+				ProjectID: project.ID,
+				Skill:     tool.Skill,
+				Icon:      tool.Icon,
+				Reason:    reason,
+			})
+			continue
+		}
+
+		tool.SkillID = skill.ID
+		tool.Skill = skill.Skill
+		tool.Icon = skill.Icon
+		linked++
+	}
+
+	return unmatched, linked, alreadyLinked
+}
+
+// resolveTools - Tool isim ve icon'larını referans edilen skill'den doldur
+// Skill silinmişse kayıtlı isim/icon kullanılır
+func (r *ProjectsRepository) resolveTools(projects []Project) error {
+	var skillIDs []string
+	seen := make(map[string]bool)
+	for i := range projects {
+		for _, skillID := range toolSkillIDs(&projects[i]) {
+			if !seen[skillID] {
+				seen[skillID] = true
+				skillIDs = append(skillIDs, skillID)
+			}
+		}
+	}
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	skillJSONs, err := r.client.MGet(r.ctx, skillIDs...).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get tool skills from Redis: %w", err)
+	}
+
+	skills := make(map[string]Skill, len(skillIDs))
+	for i, skillJSON := range skillJSONs {
+		if skillJSON == nil {
+			continue
+		}
+		var skill Skill
+		if err := skill.FromJSON(skillJSON.(string)); err != nil {
+			return fmt.Errorf("failed to unmarshal skill %s: %w", skillIDs[i], err)
+		}
+		skills[skill.ID] = skill
+	}
+
+	for i := range projects {
+		for j := range projects[i].Tools {
+			tool := &projects[i].Tools[j]
+			if skill, ok := skills[tool.SkillID]; ok {
+				tool.Skill = skill.Skill
+				tool.Icon = skill.Icon
+			}
+		}
+	}
+	return nil
+}
+
+func (r *ProjectsRepository) skillMatcher() (*skillMatcher, error) {
+	skills, err := NewSkillsRepository(r.client).GetAllSkills()
+	if err != nil {
+		return nil, err
+	}
+	return newSkillMatcher(skills), nil
+}
+
+// toolSkillIDs - Projenin referans ettiği skill ID'leri (tekrarsız)
+func toolSkillIDs(project *Project) []string {
+	var skillIDs []string
+	seen := make(map[string]bool)
+	for _, tool := range project.Tools {
+		if tool.SkillID != "" && !seen[tool.SkillID] {
+			seen[tool.SkillID] = true
+			skillIDs = append(skillIDs, tool.SkillID)
+		}
+	}
+	return skillIDs
+}
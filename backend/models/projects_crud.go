@@ -2,13 +2,19 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
 
+	"portfolio-backend/events"
+
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrSlugTaken - Açıkça verilen slug başka bir projede kullanılıyor
+var ErrSlugTaken = errors.New("slug already in use")
+
 // ProjectsRepository - Projects için CRUD operations
 type ProjectsRepository struct {
 	client *redis.Client
@@ -27,12 +33,35 @@ func NewProjectsRepository(client *redis.Client) *ProjectsRepository {
 
 // CreateProject - Yeni proje ekle
 func (r *ProjectsRepository) CreateProject(project *Project) error {
+	// Status tanımlı key'e çevrilir ("live" -> "Live"), tanımsızsa ErrUnknownStatus
+	status, err := NewProjectStatusesRepository(r.client).ResolveStatus(project.Status)
+	if err != nil {
+		return err
+	}
+	project.Status = status
+
+	// Tool'ları skill'lere bağla (eşleşmeyenler isim/icon ile kalır)
+	if _, err := r.LinkTools(project); err != nil {
+		return err
+	}
+
+	// Slug ve body analizi
+	if err := r.assignSlug(project, nil); err != nil {
+		return err
+	}
+
 	// JSON'a çevir
 	projectJSON, err := project.ToJSON()
 	if err != nil {
 		return fmt.Errorf("failed to marshal project: %w", err)
 	}
 
+	// Yeni proje manuel sıralamada sona eklenir
+	position, err := r.nextPosition()
+	if err != nil {
+		return err
+	}
+
 	// Redis'e kaydet
 	err = r.client.Set(r.ctx, project.ID, projectJSON, time.Hour*24*365).Err()
 	if err != nil {
@@ -66,6 +95,20 @@ func (r *ProjectsRepository) CreateProject(project *Project) error {
 		Member: project.ID,
 	})
 
+	// Manuel sıralama: "projects:position" (NX - zaten varsa yerini koru)
+	pipe.ZAddNX(r.ctx, "projects:position", redis.Z{
+		Score:  position,
+		Member: project.ID,
+	})
+
+	// Skill -> projeler reverse index'i
+	for _, skillID := range toolSkillIDs(project) {
+		pipe.SAdd(r.ctx, skillProjectsKey(skillID), project.ID)
+	}
+
+	// Slug index'i: "projects:slugs" (slug -> ID)
+	pipe.HSet(r.ctx, "projects:slugs", project.Slug, project.ID)
+
 	_, err = pipe.Exec(r.ctx)
 	if err != nil {
 		return fmt.Errorf("failed to update project indexes: %w", err)
@@ -76,9 +119,39 @@ func (r *ProjectsRepository) CreateProject(project *Project) error {
 
 // CreateMultipleProjects - V1'den migration için bulk insert
 func (r *ProjectsRepository) CreateMultipleProjects(projects []Project) error {
+	position, err := r.nextPosition()
+	if err != nil {
+		return err
+	}
+	matcher, err := r.skillMatcher()
+	if err != nil {
+		return err
+	}
+
+	// Status'lar yazmadan önce çözülür, tanımsız status'lu proje varsa hiçbiri yazılmaz
+	statuses, err := NewProjectStatusesRepository(r.client).GetAllStatuses()
+	if err != nil {
+		return err
+	}
+	for i := range projects {
+		status := MatchProjectStatus(statuses, projects[i].Status)
+		if status == nil {
+			return fmt.Errorf("%w: %q (project %s)", ErrUnknownStatus, projects[i].Status, projects[i].ID)
+		}
+		projects[i].Status = status.Key
+	}
+
 	pipe := r.client.Pipeline()
 
-	for _, project := range projects {
+	taken := make(map[string]string) // Aynı batch'teki slug çakışmaları için
+	for i := range projects {
+		project := &projects[i]
+		linkProjectTools(project, matcher)
+		if err := r.assignSlug(project, taken); err != nil {
+			return err
+		}
+		taken[project.Slug] = project.ID
+
 		projectJSON, err := project.ToJSON()
 		if err != nil {
 			return fmt.Errorf("failed to marshal project %s: %w", project.ID, err)
@@ -103,10 +176,27 @@ func (r *ProjectsRepository) CreateMultipleProjects(projects []Project) error {
 			Score:  float64(project.ViewCount),
 			Member: project.ID,
 		})
+		pipe.ZAddNX(r.ctx, "projects:position", redis.Z{
+			Score:  position,
+			Member: project.ID,
+		})
+		position++
+
+		for _, skillID := range toolSkillIDs(project) {
+			pipe.SAdd(r.ctx, skillProjectsKey(skillID), project.ID)
+		}
+		pipe.HSet(r.ctx, "projects:slugs", project.Slug, project.ID)
 	}
 
-	_, err := pipe.Exec(r.ctx)
-	return err
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return err
+	}
+
+	// Bulk yazımlar handler'dan geçmediği için event'leri repository yayınlar
+	for i := range projects {
+		events.Publish(events.ProjectCreated, &projects[i])
+	}
+	return nil
 }
 
 // READ Operations
@@ -127,7 +217,13 @@ func (r *ProjectsRepository) GetProjectByID(projectID string) (*Project, error)
 		return nil, fmt.Errorf("failed to unmarshal project: %w", err)
 	}
 
-	return &project, nil
+	// Tool isim/icon'larını güncel skill'den al
+	projects := []Project{project}
+	if err := r.resolveTools(projects); err != nil {
+		return nil, err
+	}
+
+	return &projects[0], nil
 }
 
 // GetAllProjects - Tüm projeleri getir
@@ -187,12 +283,41 @@ func (r *ProjectsRepository) GetStatuses() ([]string, error) {
 
 // UpdateProject - Proje güncelle
 func (r *ProjectsRepository) UpdateProject(project *Project) error {
+	// Yeni eklenen tool'ları skill'lere bağla
+	if _, err := r.LinkTools(project); err != nil {
+		return err
+	}
+	return r.saveProject(project, true)
+}
+
+// saveProject - Projeyi yaz ve değişen index'leri güncelle
+// View sayacı tool bağlama ve body analizi yapmadan (prepare=false) doğrudan bunu kullanır
+func (r *ProjectsRepository) saveProject(project *Project, prepare bool) error {
 	// Mevcut projeyi al (index güncelleme için)
 	existingProject, err := r.GetProjectByID(project.ID)
 	if err != nil {
 		return fmt.Errorf("project not found for update: %w", err)
 	}
 
+	if prepare {
+		// Değişen status tanımlı key'e çevrilir; eski (tanımsız) status'lu projeler düzenlenebilir kalır
+		if project.Status != existingProject.Status {
+			status, err := NewProjectStatusesRepository(r.client).ResolveStatus(project.Status)
+			if err != nil {
+				return err
+			}
+			project.Status = status
+		}
+
+		// Slug gönderilmediyse mevcut slug korunur (URL'ler değişmesin)
+		if project.Slug == "" {
+			project.Slug = existingProject.Slug
+		}
+		if err := r.assignSlug(project, nil); err != nil {
+			return err
+		}
+	}
+
 	// UpdatedAt güncelle
 	project.UpdatedAt = time.Now()
 
@@ -230,6 +355,26 @@ func (r *ProjectsRepository) UpdateProject(project *Project) error {
 		})
 	}
 
+	// Tool referansları değiştiyse reverse index
+	newSkillIDs := make(map[string]bool)
+	for _, skillID := range toolSkillIDs(project) {
+		newSkillIDs[skillID] = true
+		pipe.SAdd(r.ctx, skillProjectsKey(skillID), project.ID)
+	}
+	for _, skillID := range toolSkillIDs(existingProject) {
+		if !newSkillIDs[skillID] {
+			pipe.SRem(r.ctx, skillProjectsKey(skillID), project.ID)
+		}
+	}
+
+	// Slug değiştiyse index
+	if existingProject.Slug != project.Slug {
+		if existingProject.Slug != "" {
+			pipe.HDel(r.ctx, "projects:slugs", existingProject.Slug)
+		}
+		pipe.HSet(r.ctx, "projects:slugs", project.Slug, project.ID)
+	}
+
 	_, err = pipe.Exec(r.ctx)
 	if err != nil {
 		return fmt.Errorf("failed to update project indexes: %w", err)
@@ -250,7 +395,7 @@ func (r *ProjectsRepository) IncrementProjectViews(projectID string) error {
 	project.IncrementViewCount()
 
 	// Güncelle
-	return r.UpdateProject(project)
+	return r.saveProject(project, false)
 }
 
 // DELETE Operations
@@ -278,6 +423,14 @@ func (r *ProjectsRepository) DeleteProject(projectID string) error {
 	// Sorted set'lerden çıkar
 	pipe.ZRem(r.ctx, "projects:by_date", projectID)
 	pipe.ZRem(r.ctx, "projects:by_views", projectID)
+	pipe.ZRem(r.ctx, "projects:position", projectID)
+
+	for _, skillID := range toolSkillIDs(project) {
+		pipe.SRem(r.ctx, skillProjectsKey(skillID), projectID)
+	}
+	if project.Slug != "" {
+		pipe.HDel(r.ctx, "projects:slugs", project.Slug)
+	}
 
 	_, err = pipe.Exec(r.ctx)
 	if err != nil {
@@ -310,6 +463,14 @@ func (r *ProjectsRepository) DeleteAllProjects() error {
 	pipe.Del(r.ctx, "projects:statuses")
 	pipe.Del(r.ctx, "projects:by_date")
 	pipe.Del(r.ctx, "projects:by_views")
+	pipe.Del(r.ctx, "projects:position")
+	pipe.Del(r.ctx, "projects:slugs")
+
+	// Skill -> projeler index'lerini temizle
+	skillProjectKeys, _ := r.client.Keys(r.ctx, skillProjectsKeyPrefix+"*").Result()
+	for _, key := range skillProjectKeys {
+		pipe.Del(r.ctx, key)
+	}
 
 	// Status index'lerini temizle
 	statuses, _ := r.GetStatuses()
@@ -322,8 +483,299 @@ func (r *ProjectsRepository) DeleteAllProjects() error {
 	return err
 }
 
+// RebuildIndexes - Status, tarih ve view index'lerini proje verisinden yeniden oluştur
+// Bulk import sonrası boş kalan status'lar ve eski üyelikler temizlenir
+// Manuel sıralama korunur, position'ı olmayan projeler en yeniden eskiye sona eklenir
+func (r *ProjectsRepository) RebuildIndexes() error {
+	projects, err := r.GetAllProjects()
+	if err != nil {
+		return err
+	}
+
+	positions, err := r.getPositions()
+	if err != nil {
+		return err
+	}
+	sortProjectsByDate(projects)
+	next := float64(0)
+	for _, position := range positions {
+		if position >= next {
+			next = position + 1
+		}
+	}
+
+	statusKeys, err := r.client.Keys(r.ctx, "projects:status:*").Result()
+	if err != nil {
+		return fmt.Errorf("failed to get status indexes: %w", err)
+	}
+	skillProjectKeys, err := r.client.Keys(r.ctx, skillProjectsKeyPrefix+"*").Result()
+	if err != nil {
+		return fmt.Errorf("failed to get skill project indexes: %w", err)
+	}
+
+	order := make([]redis.Z, 0, len(projects))
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, append(statusKeys, "projects:all", "projects:statuses", "projects:by_date", "projects:by_views", "projects:position", "projects:slugs")...)
+	if len(skillProjectKeys) > 0 {
+		pipe.Del(r.ctx, skillProjectKeys...)
+	}
+
+	for _, project := range projects {
+		pipe.SAdd(r.ctx, "projects:all", project.ID)
+		pipe.SAdd(r.ctx, fmt.Sprintf("projects:status:%s", project.Status), project.ID)
+		pipe.SAdd(r.ctx, "projects:statuses", project.Status)
+
+		createdAt, _ := time.Parse("2006-01-02T15:04:05.000Z", project.CreatedAt)
+		pipe.ZAdd(r.ctx, "projects:by_date", redis.Z{
+			Score:  float64(createdAt.Unix()),
+			Member: project.ID,
+		})
+		pipe.ZAdd(r.ctx, "projects:by_views", redis.Z{
+			Score:  float64(project.ViewCount),
+			Member: project.ID,
+		})
+
+		position, ok := positions[project.ID]
+		if !ok {
+			position = next
+			next++
+		}
+		pipe.ZAdd(r.ctx, "projects:position", redis.Z{
+			Score:  position,
+			Member: project.ID,
+		})
+		order = append(order, redis.Z{Score: position, Member: project.ID})
+
+		for _, skillID := range toolSkillIDs(&project) {
+			pipe.SAdd(r.ctx, skillProjectsKey(skillID), project.ID)
+		}
+		if project.Slug != "" {
+			pipe.HSet(r.ctx, "projects:slugs", project.Slug, project.ID)
+		}
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to rebuild project indexes: %w", err)
+	}
+
+	// Position'ı olmayan projeler sona eklendiği için sıralama değişmiş olabilir
+	sort.SliceStable(order, func(i, j int) bool { return order[i].Score < order[j].Score })
+	projectIDs := make([]string, len(order))
+	for i, z := range order {
+		projectIDs[i] = z.Member.(string)
+	}
+	events.Publish(events.ProjectsReordered, projectIDs)
+	return nil
+}
+
+// SetOrder - Manuel sıralamayı kaydet
+// Liste tüm projeleri tam olarak bir kez içermeli (kısmi sıralama kabul edilmez)
+func (r *ProjectsRepository) SetOrder(projectIDs []string) error {
+	allIDs, err := r.client.SMembers(r.ctx, "projects:all").Result()
+	if err != nil {
+		return fmt.Errorf("failed to get project IDs: %w", err)
+	}
+
+	known := make(map[string]bool, len(allIDs))
+	for _, id := range allIDs {
+		known[id] = true
+	}
+	seen := make(map[string]bool, len(projectIDs))
+	for _, id := range projectIDs {
+		if !known[id] {
+			return fmt.Errorf("project not found: %s", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate project in order: %s", id)
+		}
+		seen[id] = true
+	}
+	for _, id := range allIDs {
+		if !seen[id] {
+			return fmt.Errorf("project missing from order: %s", id)
+		}
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, "projects:position")
+	for i, id := range projectIDs {
+		pipe.ZAdd(r.ctx, "projects:position", redis.Z{
+			Score:  float64(i),
+			Member: id,
+		})
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to save project order: %w", err)
+	}
+	return nil
+}
+
+// GetSortedProjects - Tüm projeler, istenen sıralamada (pinned olanlar önce)
+func (r *ProjectsRepository) GetSortedProjects(sortBy string) ([]Project, error) {
+	projects, err := r.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	// Eşitlikte ve position'ı olmayanlarda tarih sırası geçerli
+	sortProjectsByDate(projects)
+
+	switch sortBy {
+	case ProjectSortManual:
+		positions, err := r.getPositions()
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(projects, func(i, j int) bool {
+			positionI, okI := positions[projects[i].ID]
+			positionJ, okJ := positions[projects[j].ID]
+			if okI != okJ {
+				return okI
+			}
+			return positionI < positionJ
+		})
+	case ProjectSortPopular:
+		sort.SliceStable(projects, func(i, j int) bool {
+			return projects[i].ViewCount > projects[j].ViewCount
+		})
+	}
+
+	sort.SliceStable(projects, func(i, j int) bool {
+		return projects[i].Pinned && !projects[j].Pinned
+	})
+
+	return projects, nil
+}
+
 // Helper Methods
 
+// getPositions - projects:position sorted set'i (ID -> position)
+func (r *ProjectsRepository) getPositions() (map[string]float64, error) {
+	entries, err := r.client.ZRangeWithScores(r.ctx, "projects:position", 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project positions: %w", err)
+	}
+
+	positions := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		positions[entry.Member.(string)] = entry.Score
+	}
+	return positions, nil
+}
+
+// GetProjectBySlug - Slug'a göre proje (case study sayfası için)
+func (r *ProjectsRepository) GetProjectBySlug(slug string) (*Project, error) {
+	projectID, err := r.client.HGet(r.ctx, "projects:slugs", slug).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("project not found: %s", slug)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project slug: %w", err)
+	}
+
+	return r.GetProjectByID(projectID)
+}
+
+// SlugAssignment - Slug'ı olmayan projeye atanan slug
+type SlugAssignment struct {
+	ProjectID string `json:"project_id"`
+	Slug      string `json:"slug"`
+}
+
+// SlugAssignReport - Slug migration sonucu
+type SlugAssignReport struct {
+	DryRun   bool             `json:"dry_run"`
+	Projects int              `json:"projects"`
+	Assigned []SlugAssignment `json:"assigned"`
+}
+
+// AssignMissingSlugs - Slug'ı olmayan projelere title'dan slug ata (mevcut veri migration'ı)
+// dryRun'da hiçbir şey yazılmaz, sadece atanacak slug'lar döner
+func (r *ProjectsRepository) AssignMissingSlugs(dryRun bool) (*SlugAssignReport, error) {
+	projects, err := r.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SlugAssignReport{DryRun: dryRun, Projects: len(projects), Assigned: []SlugAssignment{}}
+	taken := make(map[string]string) // dryRun'da Redis'e yazılmayan slug'lar
+	for i := range projects {
+		project := &projects[i]
+		if project.Slug != "" {
+			continue
+		}
+
+		if dryRun {
+			err = r.assignSlug(project, taken)
+		} else {
+			err = r.saveProject(project, true)
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to assign slug to project %s: %w", project.ID, err)
+		}
+
+		taken[project.Slug] = project.ID
+		report.Assigned = append(report.Assigned, SlugAssignment{ProjectID: project.ID, Slug: project.Slug})
+		if !dryRun {
+			events.Publish(events.ProjectUpdated, project)
+		}
+	}
+
+	return report, nil
+}
+
+// assignSlug - Body'yi hazırla ve slug'ın benzersiz olduğunu kontrol et
+// Title'dan üretilen slug çakışırsa "-2", "-3" eklenir; açıkça verilen slug başka projedeyse hata
+// taken: henüz Redis'e yazılmamış slug'lar (bulk insert)
+func (r *ProjectsRepository) assignSlug(project *Project, taken map[string]string) error {
+	generated := project.Slug == ""
+	project.PrepareBody()
+	if project.Slug == "" {
+		project.Slug = "project" // Title'da hiç ASCII karakter yoksa
+	}
+
+	base := project.Slug
+	for suffix := 2; ; suffix++ {
+		owner := taken[project.Slug]
+		if owner == "" {
+			var err error
+			owner, err = r.client.HGet(r.ctx, "projects:slugs", project.Slug).Result()
+			if err != nil && err != redis.Nil {
+				return fmt.Errorf("failed to check project slug: %w", err)
+			}
+		}
+		if owner == "" || owner == project.ID {
+			return nil
+		}
+		if !generated {
+			return fmt.Errorf("%w: %s (%s)", ErrSlugTaken, project.Slug, owner)
+		}
+		project.Slug = fmt.Sprintf("%s-%d", base, suffix)
+	}
+}
+
+// nextPosition - Manuel sıralamada sonraki boş position
+func (r *ProjectsRepository) nextPosition() (float64, error) {
+	last, err := r.client.ZRevRangeWithScores(r.ctx, "projects:position", 0, 0).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get project positions: %w", err)
+	}
+	if len(last) == 0 {
+		return 0, nil
+	}
+	return last[0].Score + 1, nil
+}
+
+// sortProjectsByDate - En yeni önce
+func sortProjectsByDate(projects []Project) {
+	sort.SliceStable(projects, func(i, j int) bool {
+		timeI, _ := time.Parse("2006-01-02T15:04:05.000Z", projects[i].CreatedAt)
+		timeJ, _ := time.Parse("2006-01-02T15:04:05.000Z", projects[j].CreatedAt)
+		return timeI.After(timeJ)
+	})
+}
+
 // getProjectsByIDs - ID'lere göre projeleri al (bulk read)
 func (r *ProjectsRepository) getProjectsByIDs(projectIDs []string) ([]Project, error) {
 	if len(projectIDs) == 0 {
@@ -350,49 +802,24 @@ func (r *ProjectsRepository) getProjectsByIDs(projectIDs []string) ([]Project, e
 		projects = append(projects, project)
 	}
 
+	// Tool isim/icon'larını güncel skill'den al
+	if err := r.resolveTools(projects); err != nil {
+		return nil, err
+	}
+
 	return projects, nil
 }
 
 // GetProjectsResponse - V1 API format'ı
-func (r *ProjectsRepository) GetProjectsResponse() (*ProjectsResponse, error) {
-	projects, err := r.GetAllProjects()
+// sortBy: ProjectSortManual, ProjectSortLatest (V1 davranışı) veya ProjectSortPopular
+func (r *ProjectsRepository) GetProjectsResponse(sortBy string) (*ProjectsResponse, error) {
+	projects, err := r.GetSortedProjects(sortBy)
 	if err != nil {
 		return nil, err
 	}
 
-	// Tarihe göre sırala (en yeni first)
-	sort.Slice(projects, func(i, j int) bool {
-		timeI, _ := time.Parse("2006-01-02T15:04:05.000Z", projects[i].CreatedAt)
-		timeJ, _ := time.Parse("2006-01-02T15:04:05.000Z", projects[j].CreatedAt)
-		return timeI.After(timeJ)
-	})
-
 	return &ProjectsResponse{
 		Count:    len(projects),
 		Projects: projects,
 	}, nil
-}
-
-// GetProjectsByStatusResponse - Status'a göre gruplu response
-func (r *ProjectsRepository) GetProjectsByStatusResponse() (*ProjectsByStatus, error) {
-	liveProjects, err := r.GetProjectsByStatus("Live")
-	if err != nil {
-		return nil, err
-	}
-
-	githubProjects, err := r.GetProjectsByStatus("Github")
-	if err != nil {
-		return nil, err
-	}
-
-	inProgressProjects, err := r.GetProjectsByStatus("In Progress")
-	if err != nil {
-		return nil, err
-	}
-
-	return &ProjectsByStatus{
-		Live:       liveProjects,
-		GitHub:     githubProjects,
-		InProgress: inProgressProjects,
-	}, nil
 }
\ No newline at end of file
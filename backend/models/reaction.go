@@ -0,0 +1,56 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// PostReactions - Bir blog yazısının reaction sayıları
+// Counts: {"like": 12, "love": 3}
+type PostReactions struct {
+	PostID string         `json:"post_id"` // "blog:modern-css-techniques"
+	Counts map[string]int `json:"counts"`  // Reaction tipi başına sayı
+	Total  int            `json:"total"`   // Tüm reaction'ların toplamı
+}
+
+// ReactionStat - Admin raporu için en çok reaction alan yazı
+type ReactionStat struct {
+	PostID string         `json:"post_id"`
+	Slug   string         `json:"slug"`
+	Title  string         `json:"title"`
+	Counts map[string]int `json:"counts"`
+	Total  int            `json:"total"`
+}
+
+// NewPostReactions - Tüm tipler 0 ile başlayan reaction seti
+// Frontend her tipi sabit sırada gösterebilsin diye eksik tipler 0 olarak döner
+func NewPostReactions(postID string, types []string, counts map[string]int) *PostReactions {
+	reactions := &PostReactions{
+		PostID: postID,
+		Counts: make(map[string]int, len(types)),
+	}
+
+	for _, reactionType := range types {
+		reactions.Counts[reactionType] = counts[reactionType]
+		reactions.Total += counts[reactionType]
+	}
+
+	return reactions
+}
+
+// IsValidReactionType - Reaction tipi config'deki listede var mı?
+func IsValidReactionType(types []string, reactionType string) bool {
+	for _, t := range types {
+		if t == reactionType {
+			return true
+		}
+	}
+	return false
+}
+
+// VisitorFingerprint - Anonim ziyaretçi parmak izi
+// IP + User-Agent salt ile hash'lenir, ham IP Redis'e yazılmaz
+func VisitorFingerprint(salt, ip, userAgent string) string {
+	sum := sha256.Sum256([]byte(salt + "|" + ip + "|" + userAgent))
+	return hex.EncodeToString(sum[:])
+}
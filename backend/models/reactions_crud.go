@@ -0,0 +1,127 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ReactionsRepository - Blog reaction'ları için CRUD operations
+type ReactionsRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewReactionsRepository - Repository oluştur
+func NewReactionsRepository(client *redis.Client) *ReactionsRepository {
+	return &ReactionsRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// AddReaction - Reaction ekle (ziyaretçi başına window içinde bir kez)
+// Dedupe key: "reactions:seen:blog:slug:like:<fingerprint>" (TTL = window)
+// Dönen bool false ise aynı ziyaretçi bu tipi zaten vermiş demektir
+func (r *ReactionsRepository) AddReaction(postID, reactionType, fingerprint string, window time.Duration) (bool, error) {
+	seenKey := fmt.Sprintf("reactions:seen:%s:%s:%s", postID, reactionType, fingerprint)
+
+	// SETNX atomic - iki paralel istek aynı anda sayılmaz
+	added, err := r.client.SetNX(r.ctx, seenKey, "1", window).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check reaction dedupe: %w", err)
+	}
+	if !added {
+		return false, nil
+	}
+
+	pipe := r.client.Pipeline()
+
+	// Tip başına sayaç: "reactions:blog:slug" hash
+	pipe.HIncrBy(r.ctx, reactionsKey(postID), reactionType, 1)
+
+	// Toplam reaction sorted set (admin raporu için)
+	pipe.ZIncrBy(r.ctx, "reactions:by_total", 1, postID)
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to increment reaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetReactionCounts - Post'un reaction sayılarını getir
+func (r *ReactionsRepository) GetReactionCounts(postID string) (map[string]int, error) {
+	values, err := r.client.HGetAll(r.ctx, reactionsKey(postID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	return parseReactionCounts(values), nil
+}
+
+// GetMostReacted - En çok reaction alan post'lar
+// Slug ve Title handler'da BlogRepository'den doldurulur
+func (r *ReactionsRepository) GetMostReacted(count int) ([]ReactionStat, error) {
+	entries, err := r.client.ZRevRangeWithScores(r.ctx, "reactions:by_total", 0, int64(count-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get most reacted posts: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return []ReactionStat{}, nil
+	}
+
+	// Hash'leri tek round-trip'te al
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(entries))
+	for i, entry := range entries {
+		cmds[i] = pipe.HGetAll(r.ctx, reactionsKey(entry.Member.(string)))
+	}
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to get reaction counts: %w", err)
+	}
+
+	stats := make([]ReactionStat, 0, len(entries))
+	for i, entry := range entries {
+		stats = append(stats, ReactionStat{
+			PostID: entry.Member.(string),
+			Counts: parseReactionCounts(cmds[i].Val()),
+			Total:  int(entry.Score),
+		})
+	}
+
+	return stats, nil
+}
+
+// DeleteReactions - Post silinince reaction verilerini temizle
+// Dedupe key'leri TTL ile kendiliğinden düşer
+func (r *ReactionsRepository) DeleteReactions(postID string) error {
+	pipe := r.client.Pipeline()
+	pipe.Del(r.ctx, reactionsKey(postID))
+	pipe.ZRem(r.ctx, "reactions:by_total", postID)
+
+	_, err := pipe.Exec(r.ctx)
+	return err
+}
+
+// Helper functions
+
+func reactionsKey(postID string) string {
+	return "reactions:" + postID
+}
+
+func parseReactionCounts(values map[string]string) map[string]int {
+	counts := make(map[string]int, len(values))
+	for reactionType, value := range values {
+		count, _ := strconv.Atoi(value)
+		counts[reactionType] = count
+	}
+	return counts
+}
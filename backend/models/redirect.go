@@ -0,0 +1,70 @@
+package models
+
+import (
+	"encoding/json"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// Redirect kaynakları
+const (
+	RedirectSourceManual    = "manual"
+	RedirectSourceWordPress = "wordpress"
+	RedirectSourceSkill     = "skill" // Kategori rename/merge/move sonrası eski skill ID'leri
+)
+
+// Redirect - Eski URL'den yeni sayfaya kalıcı yönlendirme (301)
+type Redirect struct {
+	From      string    `json:"from"`   // "/2019/03/04/hello-world/" (host'suz, normalize edilmiş)
+	To        string    `json:"to"`     // "/blog/hello-world/"
+	Source    string    `json:"source"` // wordpress, manual, skill
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewRedirect - Yeni redirect oluşturucu (From normalize edilir)
+func NewRedirect(from, to, source string) *Redirect {
+	return &Redirect{
+		From:      NormalizeRedirectPath(from),
+		To:        to,
+		Source:    source,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NormalizeRedirectPath - Tam URL veya path'i lookup key'ine çevir
+// Host ve fragment atılır, sonuna / eklenir (Next.js trailingSlash: true)
+// Sadece "/?p=123" gibi query'li kök URL'lerde query korunur (WordPress shortlink)
+func NormalizeRedirectPath(raw string) string {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ""
+	}
+
+	p := parsed.Path
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	if p != "/" && !strings.HasSuffix(p, "/") && path.Ext(p) == "" {
+		p += "/"
+	}
+
+	if p == "/" && parsed.RawQuery != "" {
+		return p + "?" + parsed.RawQuery
+	}
+	return p
+}
+
+// ToJSON ve FromJSON methodları
+func (r *Redirect) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (r *Redirect) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), r)
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// From -> Redirect JSON hash'leri
+const (
+	redirectsKey      = "redirects"        // Frontend'e verilen site redirect'leri
+	skillRedirectsKey = "skills:redirects" // Eski skill ID'leri, sadece skill API'si kullanır
+)
+
+// RedirectsRepository - Redirect'ler için CRUD operations
+type RedirectsRepository struct {
+	client *redis.Client
+	ctx    context.Context
+	key    string
+}
+
+// NewRedirectsRepository - Repository oluştur
+func NewRedirectsRepository(client *redis.Client) *RedirectsRepository {
+	return &RedirectsRepository{
+		client: client,
+		ctx:    context.Background(),
+		key:    redirectsKey,
+	}
+}
+
+// NewSkillRedirectsRepository - Skill ID redirect'leri için repository
+// Path'leri "skill:Frontend:React" gibi ":" içerdiği için site redirect'lerinden ayrı tutulur
+func NewSkillRedirectsRepository(client *redis.Client) *RedirectsRepository {
+	return &RedirectsRepository{
+		client: client,
+		ctx:    context.Background(),
+		key:    skillRedirectsKey,
+	}
+}
+
+// SaveRedirect - Redirect kaydet (aynı From varsa üzerine yazılır)
+// Zincir oluşmasın diye hedefi bu redirect'in From'u olan eski kayıtlar da yeni hedefe çevrilir
+func (r *RedirectsRepository) SaveRedirect(redirect *Redirect) error {
+	if redirect.From == "" || redirect.From == redirect.To {
+		return fmt.Errorf("invalid redirect: %s -> %s", redirect.From, redirect.To)
+	}
+
+	existing, err := r.GetAllRedirects()
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.Pipeline()
+	for _, old := range existing {
+		if old.To == redirect.From && old.From != redirect.To {
+			old.To = redirect.To
+			oldJSON, err := old.ToJSON()
+			if err != nil {
+				return fmt.Errorf("failed to marshal redirect: %w", err)
+			}
+			pipe.HSet(r.ctx, r.key, old.From, oldJSON)
+		}
+	}
+
+	redirectJSON, err := redirect.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal redirect: %w", err)
+	}
+	pipe.HSet(r.ctx, r.key, redirect.From, redirectJSON)
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save redirect: %w", err)
+	}
+
+	return nil
+}
+
+// GetRedirect - Path'e göre redirect getir (path normalize edilir)
+func (r *RedirectsRepository) GetRedirect(from string) (*Redirect, error) {
+	from = NormalizeRedirectPath(from)
+
+	redirectJSON, err := r.client.HGet(r.ctx, r.key, from).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("redirect not found: %s", from)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redirect: %w", err)
+	}
+
+	var redirect Redirect
+	err = redirect.FromJSON(redirectJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal redirect: %w", err)
+	}
+
+	return &redirect, nil
+}
+
+// GetAllRedirects - Tüm redirect'ler (From'a göre sıralı)
+func (r *RedirectsRepository) GetAllRedirects() ([]Redirect, error) {
+	redirectJSONs, err := r.client.HGetAll(r.ctx, r.key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redirects: %w", err)
+	}
+
+	redirects := make([]Redirect, 0, len(redirectJSONs))
+	for from, redirectJSON := range redirectJSONs {
+		var redirect Redirect
+		err = redirect.FromJSON(redirectJSON)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal redirect %s: %w", from, err)
+		}
+		redirects = append(redirects, redirect)
+	}
+
+	sort.Slice(redirects, func(i, j int) bool {
+		return redirects[i].From < redirects[j].From
+	})
+
+	return redirects, nil
+}
+
+// DeleteRedirect - Redirect sil
+func (r *RedirectsRepository) DeleteRedirect(from string) error {
+	from = NormalizeRedirectPath(from)
+
+	deleted, err := r.client.HDel(r.ctx, r.key, from).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete redirect: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("redirect not found: %s", from)
+	}
+
+	return nil
+}
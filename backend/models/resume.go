@@ -0,0 +1,234 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Resume section key'leri
+// Admin her section'ı açıp kapatabilir ve sırasını değiştirebilir
+const (
+	ResumeSectionSummary        = "summary"        // Profile.Summary
+	ResumeSectionExperience     = "experience"     // Timeline work entry'leri (hiç yoksa ResumeSettings.Experience)
+	ResumeSectionSkills         = "skills"         // Skill'ler, kategori sırasıyla
+	ResumeSectionProjects       = "projects"       // Projeler, manuel sırayla
+	ResumeSectionEducation      = "education"      // Timeline education entry'leri
+	ResumeSectionCertifications = "certifications" // Timeline certification entry'leri
+)
+
+// ResumeSectionKeys - Desteklenen section'lar (default sıra)
+var ResumeSectionKeys = []string{
+	ResumeSectionSummary, ResumeSectionExperience, ResumeSectionSkills, ResumeSectionProjects,
+	ResumeSectionEducation, ResumeSectionCertifications,
+}
+
+// defaultResumeSectionTitles - Title boşsa kullanılır
+var defaultResumeSectionTitles = map[string]string{
+	ResumeSectionSummary:        "Summary",
+	ResumeSectionExperience:     "Experience",
+	ResumeSectionSkills:         "Skills",
+	ResumeSectionProjects:       "Projects",
+	ResumeSectionEducation:      "Education",
+	ResumeSectionCertifications: "Certifications",
+}
+
+// ResumeLocation - JSON Resume basics.location
+type ResumeLocation struct {
+	City        string `json:"city,omitempty"`
+	Region      string `json:"region,omitempty"`
+	CountryCode string `json:"country_code,omitempty"` // "TR"
+}
+
+// ResumeLink - Sosyal profil (GitHub, LinkedIn vs)
+type ResumeLink struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+// ResumeProfile - CV başlığındaki kişisel bilgiler
+type ResumeProfile struct {
+	Name     string         `json:"name"`
+	Label    string         `json:"label,omitempty"` // "Full Stack Developer"
+	Email    string         `json:"email,omitempty"`
+	Phone    string         `json:"phone,omitempty"`
+	URL      string         `json:"url,omitempty"` // Portfolio adresi
+	Image    string         `json:"image,omitempty"`
+	Summary  string         `json:"summary,omitempty"` // Düz metin, birkaç cümle
+	Location ResumeLocation `json:"location"`
+	Links    []ResumeLink   `json:"links,omitempty"`
+}
+
+// ResumeExperience - İş deneyimi ("2021-03" veya "2021-03-15", End boşsa devam ediyor)
+// Timeline'dan önce CV ayarlarında tutuluyordu; timeline'da iş deneyimi varsa kullanılmaz
+type ResumeExperience struct {
+	Organization string   `json:"organization"`
+	Position     string   `json:"position"`
+	URL          string   `json:"url,omitempty"`
+	Location     string   `json:"location,omitempty"`
+	Start        string   `json:"start"`
+	End          string   `json:"end,omitempty"`
+	Summary      string   `json:"summary,omitempty"`
+	Highlights   []string `json:"highlights,omitempty"`
+}
+
+// ResumeSection - Section görünürlüğü ve içeriği
+// Sections listesindeki sıra çıktıdaki sıradır
+type ResumeSection struct {
+	Key     string   `json:"key"`
+	Title   string   `json:"title,omitempty"` // Boşsa default başlık
+	Enabled bool     `json:"enabled"`
+	Limit   int      `json:"limit,omitempty"`   // En fazla kaç kayıt (0 = hepsi)
+	Include []string `json:"include,omitempty"` // skills: kategoriler, projects: proje ID/slug'ları (bu sırayla, boşsa hepsi)
+}
+
+// ResumeSettings - Admin tarafından yönetilen CV ayarları
+type ResumeSettings struct {
+	Profile    ResumeProfile      `json:"profile"`
+	Experience []ResumeExperience `json:"experience"` // Timeline'da work entry'si yoksa kullanılır
+	Sections   []ResumeSection    `json:"sections"`
+	UpdatedAt  time.Time          `json:"updated_at,omitempty"`
+}
+
+// DefaultResumeSettings - Henüz kaydedilmemişse tüm section'lar açık
+func DefaultResumeSettings() *ResumeSettings {
+	settings := &ResumeSettings{
+		Experience: []ResumeExperience{},
+	}
+	settings.Normalize()
+	return settings
+}
+
+// ToJSON - JSON string'e çevir
+func (s *ResumeSettings) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// FromJSON - JSON string'den struct oluştur
+func (s *ResumeSettings) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), s)
+}
+
+// Normalize - Eksik section'ları kapalı olarak sona ekle, boş başlıkları doldur
+// Yeni bir section eklendiğinde kayıtlı ayarlar da onu içerir
+func (s *ResumeSettings) Normalize() {
+	present := make(map[string]bool, len(s.Sections))
+	for _, section := range s.Sections {
+		present[section.Key] = true
+	}
+	for _, key := range ResumeSectionKeys {
+		if !present[key] {
+			// Hiç section yoksa (default ayarlar) hepsi açık
+			s.Sections = append(s.Sections, ResumeSection{Key: key, Enabled: len(present) == 0})
+		}
+	}
+	for i := range s.Sections {
+		if strings.TrimSpace(s.Sections[i].Title) == "" {
+			s.Sections[i].Title = defaultResumeSectionTitles[s.Sections[i].Key]
+		}
+	}
+	if s.Experience == nil {
+		s.Experience = []ResumeExperience{}
+	}
+}
+
+// Validate - Section key'leri, linkler ve deneyim tarihleri
+func (s *ResumeSettings) Validate() error {
+	if s.Profile.Email != "" {
+		if _, err := mail.ParseAddress(s.Profile.Email); err != nil {
+			return fmt.Errorf("invalid email: %s", s.Profile.Email)
+		}
+	}
+	if s.Profile.URL != "" && !isAbsoluteURL(s.Profile.URL) {
+		return fmt.Errorf("invalid url: %s", s.Profile.URL)
+	}
+	for i, link := range s.Profile.Links {
+		if strings.TrimSpace(link.Network) == "" {
+			return fmt.Errorf("links[%d]: network is required", i)
+		}
+		if !isAbsoluteURL(link.URL) {
+			return fmt.Errorf("links[%d]: invalid url: %s", i, link.URL)
+		}
+	}
+
+	for i, experience := range s.Experience {
+		if strings.TrimSpace(experience.Organization) == "" {
+			return fmt.Errorf("experience[%d]: organization is required", i)
+		}
+		if strings.TrimSpace(experience.Position) == "" {
+			return fmt.Errorf("experience[%d]: position is required", i)
+		}
+		if experience.URL != "" && !isAbsoluteURL(experience.URL) {
+			return fmt.Errorf("experience[%d]: invalid url: %s", i, experience.URL)
+		}
+		if err := validateDateRange(experience.Start, experience.End); err != nil {
+			return fmt.Errorf("experience[%d]: %w", i, err)
+		}
+	}
+
+	seen := make(map[string]bool, len(s.Sections))
+	for _, section := range s.Sections {
+		if _, ok := defaultResumeSectionTitles[section.Key]; !ok {
+			return fmt.Errorf("unknown resume section: %s", section.Key)
+		}
+		if seen[section.Key] {
+			return fmt.Errorf("duplicate resume section: %s", section.Key)
+		}
+		seen[section.Key] = true
+		if section.Limit < 0 {
+			return fmt.Errorf("invalid limit for section %s: %d", section.Key, section.Limit)
+		}
+	}
+	return nil
+}
+
+// Section - Key'e göre section (yoksa nil)
+func (s *ResumeSettings) Section(key string) *ResumeSection {
+	for i := range s.Sections {
+		if s.Sections[i].Key == key {
+			return &s.Sections[i]
+		}
+	}
+	return nil
+}
+
+// SortedExperience - Deneyimler, devam edenler ve en yeni başlayanlar önce
+func (s *ResumeSettings) SortedExperience() []ResumeExperience {
+	experience := make([]ResumeExperience, len(s.Experience))
+	copy(experience, s.Experience)
+	sort.SliceStable(experience, func(i, j int) bool {
+		a, b := experience[i], experience[j]
+		if (a.End == "") != (b.End == "") {
+			return a.End == ""
+		}
+		// Aynı formatta ("2021-03" / "2021-03-15") string karşılaştırması tarih sırasıdır
+		return a.Start > b.Start
+	})
+	return experience
+}
+
+// validateDateRange - Start zorunlu, End boş veya Start'tan sonra
+func validateDateRange(start, end string) error {
+	startDate, err := parseTimeframeDate(start)
+	if err != nil || start == "" {
+		return fmt.Errorf("invalid start: %q (YYYY-MM or YYYY-MM-DD)", start)
+	}
+	if end != "" {
+		endDate, err := parseTimeframeDate(end)
+		if err != nil {
+			return fmt.Errorf("invalid end: %q (YYYY-MM or YYYY-MM-DD)", end)
+		}
+		if endDate.Before(startDate) {
+			return fmt.Errorf("end is before start")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// resumeSettingsKey - Tek ResumeSettings JSON'ı
+const resumeSettingsKey = "resume:settings"
+
+// ResumeRepository - CV ayarları için CRUD operations
+type ResumeRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewResumeRepository - Repository oluştur
+func NewResumeRepository(client *redis.Client) *ResumeRepository {
+	return &ResumeRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// GetSettings - Kayıtlı ayarlar, hiç kaydedilmemişse default'lar
+func (r *ResumeRepository) GetSettings() (*ResumeSettings, error) {
+	settingsJSON, err := r.client.Get(r.ctx, resumeSettingsKey).Result()
+	if err == redis.Nil {
+		return DefaultResumeSettings(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume settings: %w", err)
+	}
+
+	var settings ResumeSettings
+	if err := settings.FromJSON(settingsJSON); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resume settings: %w", err)
+	}
+	settings.Normalize()
+	return &settings, nil
+}
+
+// SaveSettings - Ayarları kaydet (Validate handler'da çağrılır)
+func (r *ResumeRepository) SaveSettings(settings *ResumeSettings) error {
+	settings.Normalize()
+	settings.UpdatedAt = time.Now()
+
+	settingsJSON, err := settings.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal resume settings: %w", err)
+	}
+
+	// Status tanımları gibi TTL yok (ayar kaybolursa CV default'a döner)
+	if err := r.client.Set(r.ctx, resumeSettingsKey, settingsJSON, 0).Err(); err != nil {
+		return fmt.Errorf("failed to save resume settings: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -9,14 +11,20 @@ import (
 // Go'da struct = JavaScript'teki class/interface
 type Skill struct {
 	// JSON tag'leri API response'unda nasıl görüneceğini belirtir
-	ID       string `json:"id"`       // Redis key için
-	Category string `json:"category"` // "Languages", "Frameworks" vs
-	Skill    string `json:"skill"`    // "JavaScript", "React" vs  
-	Icon     string `json:"icon"`     // Local uploads or external URL
+	ID       string `json:"id" yaml:"id"`             // Redis key için
+	Category string `json:"category" yaml:"category"` // "Languages", "Frameworks" vs
+	Skill    string `json:"skill" yaml:"skill"`       // "JavaScript", "React" vs  
+	Icon     string `json:"icon" yaml:"icon"`         // Local uploads or external URL
+
+	// Deneyim bilgisi - hepsi opsiyonel, boşsa V1 response'unda görünmez
+	Proficiency    int     `json:"proficiency,omitempty" yaml:"proficiency,omitempty"`         // 1 (başlangıç) - 5 (uzman)
+	Years          float64 `json:"years,omitempty" yaml:"years,omitempty"`                     // Deneyim yılı: 2.5
+	FirstUsed      string  `json:"first_used,omitempty" yaml:"first_used,omitempty"`           // "2019", "2019-03" veya "2019-03-15"
+	CurrentlyUsing bool    `json:"currently_using,omitempty" yaml:"currently_using,omitempty"` // Hâlâ aktif kullanılıyor mu
 	
 	// Metadata (V1'de yoktu ama V2'de ekleyebiliriz)
-	CreatedAt time.Time `json:"created_at,omitempty"` // omitempty = boşsa JSON'a dahil etme
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"` // omitempty = boşsa JSON'a dahil etme
+	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
 }
 
 // SkillCategory struct - Kategori bilgisi için
@@ -48,7 +56,7 @@ func NewSkill(category, skill, icon string) *Skill {
 // generateSkillID - Skill için unique ID oluştur
 // Redis key format: "skill:languages:javascript"
 func generateSkillID(category, skill string) string {
-	return "skill:" + category + ":" + skill
+	return SkillKeyPrefix + category + ":" + skill
 }
 
 // ToJSON - Struct'ı JSON string'e çevir
@@ -95,4 +103,68 @@ func GetCategories(skills []Skill) []string {
 	}
 	
 	return categories
-}
\ No newline at end of file
+}
+
+// Validate - Zorunlu alanlar ve ID formatı (bulk import için)
+// ID SkillKeyPrefix ile başlamalı
+func (s *Skill) Validate() error {
+	if strings.TrimSpace(s.Category) == "" {
+		return fmt.Errorf("category is required")
+	}
+	if strings.TrimSpace(s.Skill) == "" {
+		return fmt.Errorf("skill is required")
+	}
+	if !strings.HasPrefix(s.ID, SkillKeyPrefix) {
+		return fmt.Errorf("invalid skill id: %s (must start with skill:)", s.ID)
+	}
+	return s.ValidateExperience()
+}
+
+// ValidateExperience - Proficiency, yıl ve ilk kullanım tarihi formatı
+// Create/Update handler'ları da bunu kontrol eder
+func (s *Skill) ValidateExperience() error {
+	if s.Proficiency < 0 || s.Proficiency > MaxSkillProficiency {
+		return fmt.Errorf("invalid proficiency: %d (1-%d)", s.Proficiency, MaxSkillProficiency)
+	}
+	if s.Years < 0 || s.Years > 100 {
+		return fmt.Errorf("invalid years: %v", s.Years)
+	}
+	if s.FirstUsed != "" {
+		firstUsed, err := parseSkillDate(s.FirstUsed)
+		if err != nil {
+			return fmt.Errorf("invalid first_used: %q (YYYY, YYYY-MM or YYYY-MM-DD)", s.FirstUsed)
+		}
+		if firstUsed.After(time.Now()) {
+			return fmt.Errorf("first_used is in the future: %s", s.FirstUsed)
+		}
+	}
+	return nil
+}
+
+// ExperienceYears - Girilen yıl, yoksa ilk kullanım tarihinden bugüne geçen süre
+// Hiçbiri yoksa 0 (experience sıralamasında en sona düşer)
+func (s *Skill) ExperienceYears() float64 {
+	if s.Years > 0 {
+		return s.Years
+	}
+	if s.FirstUsed == "" {
+		return 0
+	}
+	firstUsed, err := parseSkillDate(s.FirstUsed)
+	if err != nil {
+		return 0
+	}
+	years := time.Since(firstUsed).Hours() / 24 / 365.25
+	if years < 0 {
+		return 0
+	}
+	return years
+}
+
+// parseSkillDate - "2019", "2019-03" veya "2019-03-15"
+func parseSkillDate(value string) (time.Time, error) {
+	if t, err := time.Parse("2006", value); err == nil {
+		return t, nil
+	}
+	return parseTimeframeDate(value)
+}
@@ -0,0 +1,528 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrCategoryExists - Rename hedefi zaten var (merge kullanılmalı)
+	ErrCategoryExists = errors.New("category already exists")
+	// ErrSkillConflict - Hedef kategoride aynı isimde skill var
+	ErrSkillConflict = errors.New("skill already exists in target category")
+	// ErrSkillCategoryChange - Kategori ID'nin parçası, UpdateSkill ile değiştirilemez (MoveSkill kullanılmalı)
+	ErrSkillCategoryChange = errors.New("skill category cannot be changed by update, move the skill instead")
+)
+
+// SkillMove - Taşınan skill'in eski ve yeni ID'si
+type SkillMove struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// SkillMoveReport - Rename/merge/move sonucu (dry-run'da plan)
+type SkillMoveReport struct {
+	DryRun   bool        `json:"dry_run"`
+	From     string      `json:"from"` // Kaynak kategori
+	To       string      `json:"to"`   // Hedef kategori
+	Moves    []SkillMove `json:"moves"`
+	Projects []string    `json:"projects"` // Tool referansı güncellenen projeler
+	Timeline []string    `json:"timeline"` // Skill referansı güncellenen timeline entry'leri
+}
+
+// SkillRedirectPath - Skill ID'sinin redirect path'i: "/skills/skill:Frontend:React/"
+// ID değişince eski path yeni ID'ye yönlendirilir
+// From ve To aynı şekilde normalize edilir, böylece zincirler SaveRedirect'te birleşir
+func SkillRedirectPath(skillID string) string {
+	return NormalizeRedirectPath("/skills/" + url.PathEscape(skillID))
+}
+
+// SkillIDFromRedirectPath - SkillRedirectPath'in tersi
+func SkillIDFromRedirectPath(path string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(path, "/skills/"), "/")
+}
+
+// RenameCategory - Kategorinin adını değiştir, tüm skill ID'leri yeni kategoriyle yeniden yazılır
+// Hedef kategori varsa ErrCategoryExists (MergeCategories kullanılmalı)
+func (r *SkillsRepository) RenameCategory(from, to string, dryRun bool) (*SkillMoveReport, error) {
+	to = strings.TrimSpace(to)
+	if to == "" || to == from {
+		return nil, fmt.Errorf("invalid category name: %q", to)
+	}
+	if err := r.requireCategory(from); err != nil {
+		return nil, err
+	}
+	exists, err := r.client.SIsMember(r.ctx, "skills:categories", to).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check category: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: %s", ErrCategoryExists, to)
+	}
+
+	return r.moveSkills(from, to, "", dryRun)
+}
+
+// MergeCategories - Kaynak kategorideki tüm skill'leri hedefe taşı ve kaynağı kaldır
+// Skill'ler hedefin sonuna, kaynaktaki sıralarıyla eklenir
+func (r *SkillsRepository) MergeCategories(from, into string, dryRun bool) (*SkillMoveReport, error) {
+	if from == into {
+		return nil, fmt.Errorf("cannot merge category into itself: %s", from)
+	}
+	if err := r.requireCategory(from); err != nil {
+		return nil, err
+	}
+	if err := r.requireCategory(into); err != nil {
+		return nil, err
+	}
+
+	return r.moveSkills(from, into, "", dryRun)
+}
+
+// MoveSkill - Tek skill'i başka kategoriye taşı (ID yeniden yazılır, CreatedAt korunur)
+// Kaynak kategoride başka skill kalmazsa kategori de kaldırılır
+func (r *SkillsRepository) MoveSkill(skillID, category string, dryRun bool) (*SkillMoveReport, error) {
+	category = strings.TrimSpace(category)
+	if category == "" {
+		return nil, fmt.Errorf("category is required")
+	}
+
+	skill, err := r.GetSkillByID(skillID)
+	if err != nil {
+		return nil, err
+	}
+	if skill.Category == category {
+		return nil, fmt.Errorf("skill is already in category: %s", category)
+	}
+	return r.moveSkills(skill.Category, category, skillID, dryRun)
+}
+
+// skillMovePlan - moveSkills'in okuduğu durum ve yapılacak taşımalar
+type skillMovePlan struct {
+	report     *SkillMoveReport
+	skills     []Skill           // Sıralı, taşınacak skill'ler
+	newIDs     map[string]string // eski ID -> yeni ID
+	conflicts  []string          // Hedefte zaten olan ID'ler
+	dropSource bool              // Kaynak kategori tamamen boşalıyor
+}
+
+// moveSkills - Skill'leri hedef kategoriye taşı (only boşsa kaynak kategorideki tüm skill'ler)
+// Skill key'leri, kategori/sıralama index'leri, projelerin tool referansları, timeline entry'lerinin
+// skill referansları, reverse index'ler ve eski ID'lerin redirect'leri tek transaction'da yeniden yazılır
+// Kaynak kategori tamamen boşalırsa index'leri ve sırası silinir
+func (r *SkillsRepository) moveSkills(from, to, only string, dryRun bool) (*SkillMoveReport, error) {
+	if dryRun {
+		plan, err := r.planSkillMove(r.client, func(...string) error { return nil }, from, to, only)
+		if err != nil {
+			return nil, err
+		}
+		plan.report.DryRun = true
+		return plan.report, plan.conflictErr()
+	}
+
+	var report *SkillMoveReport
+
+	// Her key okunmadan önce WATCH edilir: taşıma sırasında kategoriye eklenen/düzenlenen skill,
+	// proje, entry veya redirect olursa transaction iptal edilir
+	err := r.client.Watch(r.ctx, func(tx *redis.Tx) error {
+		watch := func(keys ...string) error {
+			if len(keys) == 0 {
+				return nil
+			}
+			return tx.Watch(r.ctx, keys...).Err()
+		}
+
+		plan, err := r.planSkillMove(tx, watch, from, to, only)
+		if err != nil {
+			return err
+		}
+		report = plan.report
+		if err := plan.conflictErr(); err != nil {
+			return err
+		}
+
+		projects, err := r.readProjectsTx(tx, report.Projects)
+		if err != nil {
+			return err
+		}
+		entries, err := r.readTimelineTx(tx, report.Timeline)
+		if err != nil {
+			return err
+		}
+		redirects, err := r.readSkillRedirectsTx(tx)
+		if err != nil {
+			return err
+		}
+		position, err := r.nextScoreTx(tx, skillPositionKey(to))
+		if err != nil {
+			return err
+		}
+		fromRank, fromRanked, err := r.scoreTx(tx, skillCategoryOrderKey, from)
+		if err != nil {
+			return err
+		}
+		_, toRanked, err := r.scoreTx(tx, skillCategoryOrderKey, to)
+		if err != nil {
+			return err
+		}
+		nextRank, err := r.nextScoreTx(tx, skillCategoryOrderKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(r.ctx, func(pipe redis.Pipeliner) error {
+			now := time.Now()
+			for _, skill := range plan.skills {
+				newID := plan.newIDs[skill.ID]
+				moved := skill
+				moved.ID = newID
+				moved.Category = to
+				moved.UpdatedAt = now
+
+				skillJSON, err := moved.ToJSON()
+				if err != nil {
+					return fmt.Errorf("failed to marshal skill %s: %w", newID, err)
+				}
+				pipe.Del(r.ctx, skill.ID)
+				pipe.Set(r.ctx, newID, skillJSON, time.Hour*24*365)
+
+				pipe.SRem(r.ctx, fmt.Sprintf("skills:category:%s", from), skill.ID)
+				pipe.SAdd(r.ctx, fmt.Sprintf("skills:category:%s", to), newID)
+				pipe.ZRem(r.ctx, skillPositionKey(from), skill.ID)
+				pipe.ZAdd(r.ctx, skillPositionKey(to), redis.Z{Score: position, Member: newID})
+				position++
+
+				// Skill -> projeler index'i yeni ID'ye taşınır
+				pipe.SUnionStore(r.ctx, skillProjectsKey(newID), skillProjectsKey(newID), skillProjectsKey(skill.ID))
+				pipe.Del(r.ctx, skillProjectsKey(skill.ID))
+				pipe.SUnionStore(r.ctx, skillTimelineKey(newID), skillTimelineKey(newID), skillTimelineKey(skill.ID))
+				pipe.Del(r.ctx, skillTimelineKey(skill.ID))
+			}
+
+			for _, project := range projects {
+				for i := range project.Tools {
+					if newID, ok := plan.newIDs[project.Tools[i].SkillID]; ok {
+						project.Tools[i].SkillID = newID
+					}
+				}
+				projectJSON, err := project.ToJSON()
+				if err != nil {
+					return fmt.Errorf("failed to marshal project %s: %w", project.ID, err)
+				}
+				pipe.Set(r.ctx, project.ID, projectJSON, time.Hour*24*365)
+			}
+
+			for _, entry := range entries {
+				for i := range entry.SkillIDs {
+					if newID, ok := plan.newIDs[entry.SkillIDs[i]]; ok {
+						entry.SkillIDs[i] = newID
+					}
+				}
+				entryJSON, err := entry.ToJSON()
+				if err != nil {
+					return fmt.Errorf("failed to marshal timeline entry %s: %w", entry.ID, err)
+				}
+				pipe.Set(r.ctx, entry.ID, entryJSON, time.Hour*24*365)
+			}
+
+			if err := writeSkillRedirects(r.ctx, pipe, redirects, report.Moves); err != nil {
+				return err
+			}
+
+			pipe.SAdd(r.ctx, "skills:categories", to)
+			// Rename'de hedef kaynağın sırasını alır, merge'de hedefin sırası korunur
+			if !toRanked {
+				rank := nextRank
+				if fromRanked && plan.dropSource {
+					rank = fromRank
+				}
+				pipe.ZAdd(r.ctx, skillCategoryOrderKey, redis.Z{Score: rank, Member: to})
+			}
+			if plan.dropSource {
+				pipe.SRem(r.ctx, "skills:categories", from)
+				pipe.ZRem(r.ctx, skillCategoryOrderKey, from)
+				pipe.Del(r.ctx, fmt.Sprintf("skills:category:%s", from), skillPositionKey(from))
+			}
+			return nil
+		})
+		return err
+	}, fmt.Sprintf("skills:category:%s", from), skillPositionKey(from), skillPositionKey(to), skillCategoryOrderKey, skillRedirectsKey)
+	if err == redis.TxFailedErr {
+		return report, fmt.Errorf("skills, projects or timeline entries changed during the move, please retry")
+	}
+	if err != nil {
+		if errors.Is(err, ErrSkillConflict) {
+			return report, err
+		}
+		return report, fmt.Errorf("failed to move skills: %w", err)
+	}
+
+	return report, nil
+}
+
+// planSkillMove - Taşınacak skill'leri, yeni ID'leri, çakışmaları ve etkilenen proje/entry'leri oku
+// c: dry run'da client, taşımada transaction; watch her key grubu okunmadan önce çağrılır
+func (r *SkillsRepository) planSkillMove(c redis.Cmdable, watch func(keys ...string) error, from, to, only string) (*skillMovePlan, error) {
+	members, err := c.SMembers(r.ctx, fmt.Sprintf("skills:category:%s", from)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category skills: %w", err)
+	}
+
+	skillIDs := members
+	if only != "" {
+		found := false
+		for _, id := range members {
+			found = found || id == only
+		}
+		if !found {
+			return nil, fmt.Errorf("skill not found in category %s: %s", from, only)
+		}
+		skillIDs = []string{only}
+	}
+	if err := watch(skillIDs...); err != nil {
+		return nil, err
+	}
+
+	skills, err := r.readSkillsTx(c, skillIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.sortSkillsByOrder(skills); err != nil {
+		return nil, err
+	}
+
+	plan := &skillMovePlan{
+		report:     &SkillMoveReport{From: from, To: to, Moves: []SkillMove{}, Projects: []string{}, Timeline: []string{}},
+		skills:     skills,
+		newIDs:     make(map[string]string, len(skills)),
+		dropSource: only == "" || len(members) <= 1,
+	}
+
+	var indexKeys []string
+	for _, skill := range skills {
+		newID := generateSkillID(to, skill.Skill)
+		plan.newIDs[skill.ID] = newID
+		plan.report.Moves = append(plan.report.Moves, SkillMove{From: skill.ID, To: newID})
+		indexKeys = append(indexKeys, newID, skillProjectsKey(skill.ID), skillTimelineKey(skill.ID))
+	}
+	if err := watch(indexKeys...); err != nil {
+		return nil, err
+	}
+
+	// Hedefte aynı ID'li skill varsa hiçbir şey taşınmaz
+	projectSet := make(map[string]bool)
+	entrySet := make(map[string]bool)
+	for _, move := range plan.report.Moves {
+		if _, moving := plan.newIDs[move.To]; !moving {
+			exists, err := c.Exists(r.ctx, move.To).Result()
+			if err != nil {
+				return nil, fmt.Errorf("failed to check skill: %w", err)
+			}
+			if exists > 0 {
+				plan.conflicts = append(plan.conflicts, move.To)
+			}
+		}
+
+		projectIDs, err := c.SMembers(r.ctx, skillProjectsKey(move.From)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get skill projects: %w", err)
+		}
+		for _, projectID := range projectIDs {
+			if !projectSet[projectID] {
+				projectSet[projectID] = true
+				plan.report.Projects = append(plan.report.Projects, projectID)
+			}
+		}
+
+		entryIDs, err := c.SMembers(r.ctx, skillTimelineKey(move.From)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get skill timeline entries: %w", err)
+		}
+		for _, entryID := range entryIDs {
+			if !entrySet[entryID] {
+				entrySet[entryID] = true
+				plan.report.Timeline = append(plan.report.Timeline, entryID)
+			}
+		}
+	}
+
+	if err := watch(append(append([]string{}, plan.report.Projects...), plan.report.Timeline...)...); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// conflictErr - Hedefte aynı ID'li skill varsa ErrSkillConflict
+func (p *skillMovePlan) conflictErr() error {
+	if len(p.conflicts) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrSkillConflict, strings.Join(p.conflicts, ", "))
+}
+
+// writeSkillRedirects - Eski ID'ler yeni ID'ye yönlendirilir; yeni ID artık canlı, ona giden redirect silinir
+// Zincir oluşmasın diye eski ID'ye giden redirect'ler de yeni ID'ye çevrilir (SaveRedirect ile aynı kural)
+func writeSkillRedirects(ctx context.Context, pipe redis.Pipeliner, redirects map[string]*Redirect, moves []SkillMove) error {
+	changed := make(map[string]bool)
+	for _, move := range moves {
+		from, to := SkillRedirectPath(move.From), SkillRedirectPath(move.To)
+
+		if _, ok := redirects[to]; ok {
+			delete(redirects, to)
+			delete(changed, to)
+			pipe.HDel(ctx, skillRedirectsKey, to)
+		}
+		for path, redirect := range redirects {
+			if redirect.To == from {
+				redirect.To = to
+				changed[path] = true
+			}
+		}
+		redirects[from] = NewRedirect(from, to, RedirectSourceSkill)
+		changed[from] = true
+	}
+
+	for path := range changed {
+		redirectJSON, err := redirects[path].ToJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal redirect: %w", err)
+		}
+		pipe.HSet(ctx, skillRedirectsKey, path, redirectJSON)
+	}
+	return nil
+}
+
+// requireCategory - Kategori yoksa hata
+func (r *SkillsRepository) requireCategory(category string) error {
+	exists, err := r.client.SIsMember(r.ctx, "skills:categories", category).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check category: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("category not found: %s", category)
+	}
+	return nil
+}
+
+// readProjectsTx - Projeleri WATCH edilen transaction içinden oku
+func (r *SkillsRepository) readProjectsTx(tx *redis.Tx, projectIDs []string) ([]Project, error) {
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+
+	projectJSONs, err := tx.MGet(r.ctx, projectIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects from Redis: %w", err)
+	}
+
+	projects := make([]Project, 0, len(projectJSONs))
+	for i, projectJSON := range projectJSONs {
+		if projectJSON == nil {
+			continue // Silinmiş proje, reverse index'te kalmış
+		}
+		var project Project
+		if err := project.FromJSON(projectJSON.(string)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal project %s: %w", projectIDs[i], err)
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+// readSkillsTx - Skill'leri WATCH edilen transaction (veya dry run'da client) içinden oku
+func (r *SkillsRepository) readSkillsTx(c redis.Cmdable, skillIDs []string) ([]Skill, error) {
+	if len(skillIDs) == 0 {
+		return []Skill{}, nil
+	}
+
+	skillJSONs, err := c.MGet(r.ctx, skillIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skills from Redis: %w", err)
+	}
+
+	skills := make([]Skill, 0, len(skillJSONs))
+	for i, skillJSON := range skillJSONs {
+		if skillJSON == nil {
+			continue // Silinmiş skill, kategori index'inde kalmış
+		}
+		var skill Skill
+		if err := skill.FromJSON(skillJSON.(string)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal skill %s: %w", skillIDs[i], err)
+		}
+		skills = append(skills, skill)
+	}
+	return skills, nil
+}
+
+// readSkillRedirectsTx - Skill redirect'leri (path -> redirect) WATCH edilen transaction içinden oku
+func (r *SkillsRepository) readSkillRedirectsTx(tx *redis.Tx) (map[string]*Redirect, error) {
+	redirectJSONs, err := tx.HGetAll(r.ctx, skillRedirectsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill redirects: %w", err)
+	}
+
+	redirects := make(map[string]*Redirect, len(redirectJSONs))
+	for from, redirectJSON := range redirectJSONs {
+		var redirect Redirect
+		if err := redirect.FromJSON(redirectJSON); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal redirect %s: %w", from, err)
+		}
+		redirects[from] = &redirect
+	}
+	return redirects, nil
+}
+
+// readTimelineTx - Timeline entry'lerini WATCH edilen transaction içinden oku
+func (r *SkillsRepository) readTimelineTx(tx *redis.Tx, entryIDs []string) ([]TimelineEntry, error) {
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	entryJSONs, err := tx.MGet(r.ctx, entryIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline entries from Redis: %w", err)
+	}
+
+	entries := make([]TimelineEntry, 0, len(entryJSONs))
+	for i, entryJSON := range entryJSONs {
+		if entryJSON == nil {
+			continue // Silinmiş entry, reverse index'te kalmış
+		}
+		var entry TimelineEntry
+		if err := entry.FromJSON(entryJSON.(string)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal timeline entry %s: %w", entryIDs[i], err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// nextScoreTx - nextScore'un transaction içi hali
+func (r *SkillsRepository) nextScoreTx(tx *redis.Tx, key string) (float64, error) {
+	last, err := tx.ZRevRangeWithScores(r.ctx, key, 0, 0).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	if len(last) == 0 {
+		return 0, nil
+	}
+	return last[0].Score + 1, nil
+}
+
+// scoreTx - Member'ın score'u, yoksa false
+func (r *SkillsRepository) scoreTx(tx *redis.Tx, key, member string) (float64, bool, error) {
+	score, err := tx.ZScore(r.ctx, key, member).Result()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	return score, true, nil
+}
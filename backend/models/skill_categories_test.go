@@ -0,0 +1,362 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// skillFixture - Frontend (React, Vue) ve Backend (Go) kategorileri,
+// React'i kullanan bir proje ve bir timeline entry'si
+type skillFixture struct {
+	client    *redis.Client
+	skills    *SkillsRepository
+	projectID string
+	entryID   string
+}
+
+func newSkillFixture(t *testing.T) *skillFixture {
+	t.Helper()
+	_, client := newTestRedis(t)
+
+	skills := NewSkillsRepository(client)
+	for _, skill := range []*Skill{
+		NewSkill("Frontend", "React", "/skills-upload/react.svg"),
+		NewSkill("Frontend", "Vue", "/skills-upload/vue.svg"),
+		NewSkill("Backend", "Go", "/skills-upload/go.svg"),
+	} {
+		if err := skills.CreateSkill(skill); err != nil {
+			t.Fatalf("CreateSkill(%s) error = %v", skill.ID, err)
+		}
+	}
+
+	project := NewProject("Site", "Portfolio", "", "", "Live", []ProjectTool{
+		{SkillID: "skill:Frontend:React", Skill: "React"},
+	})
+	if err := NewProjectsRepository(client).CreateProject(project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+
+	entry := NewTimelineEntry(TimelineTypeWork, "Acme", "Developer", "2020-01", "")
+	entry.SkillIDs = []string{"skill:Frontend:React", "skill:Backend:Go"}
+	if err := NewTimelineRepository(client).CreateEntry(entry); err != nil {
+		t.Fatalf("CreateEntry() error = %v", err)
+	}
+
+	return &skillFixture{client: client, skills: skills, projectID: project.ID, entryID: entry.ID}
+}
+
+// state - Kategori sırası ve her kategorideki skill ID'leri (sırasıyla)
+func (f *skillFixture) state(t *testing.T) (categories []string, positions map[string][]string) {
+	t.Helper()
+
+	categories, err := f.skills.GetCategories()
+	if err != nil {
+		t.Fatalf("GetCategories() error = %v", err)
+	}
+	positions = make(map[string][]string, len(categories))
+	for _, category := range categories {
+		ids, err := f.client.ZRange(f.skills.ctx, skillPositionKey(category), 0, -1).Result()
+		if err != nil {
+			t.Fatalf("ZRange(%s) error = %v", category, err)
+		}
+		positions[category] = ids
+	}
+	return categories, positions
+}
+
+// redirects - Eski skill ID -> yeni skill ID
+func (f *skillFixture) redirects(t *testing.T) map[string]string {
+	t.Helper()
+
+	all, err := NewSkillRedirectsRepository(f.client).GetAllRedirects()
+	if err != nil {
+		t.Fatalf("GetAllRedirects() error = %v", err)
+	}
+	redirects := make(map[string]string, len(all))
+	for _, redirect := range all {
+		redirects[SkillIDFromRedirectPath(redirect.From)] = SkillIDFromRedirectPath(redirect.To)
+	}
+	return redirects
+}
+
+// references - Projenin tool'u ve timeline entry'sinin skill ID'leri
+func (f *skillFixture) references(t *testing.T) (tool string, timeline []string) {
+	t.Helper()
+
+	project, err := NewProjectsRepository(f.client).GetProjectByID(f.projectID)
+	if err != nil {
+		t.Fatalf("GetProjectByID() error = %v", err)
+	}
+	entry, err := NewTimelineRepository(f.client).GetEntryByID(f.entryID)
+	if err != nil {
+		t.Fatalf("GetEntryByID() error = %v", err)
+	}
+	return project.Tools[0].SkillID, entry.SkillIDs
+}
+
+func TestSkillMoves(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, r *SkillsRepository)
+		move    func(r *SkillsRepository) (*SkillMoveReport, error)
+		wantErr error
+
+		wantMoves      []SkillMove
+		wantCategories []string
+		wantPositions  map[string][]string
+		wantRedirects  map[string]string
+		wantTool       string
+		wantTimeline   []string
+	}{
+		{
+			name: "rename rewrites every skill and keeps the category rank",
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.RenameCategory("Frontend", "Web", false)
+			},
+			wantMoves: []SkillMove{
+				{From: "skill:Frontend:React", To: "skill:Web:React"},
+				{From: "skill:Frontend:Vue", To: "skill:Web:Vue"},
+			},
+			wantCategories: []string{"Web", "Backend"},
+			wantPositions: map[string][]string{
+				"Web":     {"skill:Web:React", "skill:Web:Vue"},
+				"Backend": {"skill:Backend:Go"},
+			},
+			wantRedirects: map[string]string{
+				"skill:Frontend:React": "skill:Web:React",
+				"skill:Frontend:Vue":   "skill:Web:Vue",
+			},
+			wantTool:     "skill:Web:React",
+			wantTimeline: []string{"skill:Web:React", "skill:Backend:Go"},
+		},
+		{
+			name: "merge appends to the target and drops the source",
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.MergeCategories("Frontend", "Backend", false)
+			},
+			wantMoves: []SkillMove{
+				{From: "skill:Frontend:React", To: "skill:Backend:React"},
+				{From: "skill:Frontend:Vue", To: "skill:Backend:Vue"},
+			},
+			wantCategories: []string{"Backend"},
+			wantPositions: map[string][]string{
+				"Backend": {"skill:Backend:Go", "skill:Backend:React", "skill:Backend:Vue"},
+			},
+			wantRedirects: map[string]string{
+				"skill:Frontend:React": "skill:Backend:React",
+				"skill:Frontend:Vue":   "skill:Backend:Vue",
+			},
+			wantTool:     "skill:Backend:React",
+			wantTimeline: []string{"skill:Backend:React", "skill:Backend:Go"},
+		},
+		{
+			name: "moving one skill keeps the rest of the source category",
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.MoveSkill("skill:Frontend:React", "Backend", false)
+			},
+			wantMoves:      []SkillMove{{From: "skill:Frontend:React", To: "skill:Backend:React"}},
+			wantCategories: []string{"Frontend", "Backend"},
+			wantPositions: map[string][]string{
+				"Frontend": {"skill:Frontend:Vue"},
+				"Backend":  {"skill:Backend:Go", "skill:Backend:React"},
+			},
+			wantRedirects: map[string]string{"skill:Frontend:React": "skill:Backend:React"},
+			wantTool:      "skill:Backend:React",
+			wantTimeline:  []string{"skill:Backend:React", "skill:Backend:Go"},
+		},
+		{
+			name: "moving the last skill drops the source category",
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.MoveSkill("skill:Backend:Go", "Frontend", false)
+			},
+			wantMoves:      []SkillMove{{From: "skill:Backend:Go", To: "skill:Frontend:Go"}},
+			wantCategories: []string{"Frontend"},
+			wantPositions: map[string][]string{
+				"Frontend": {"skill:Frontend:React", "skill:Frontend:Vue", "skill:Frontend:Go"},
+			},
+			wantRedirects: map[string]string{"skill:Backend:Go": "skill:Frontend:Go"},
+			wantTool:      "skill:Frontend:React",
+			wantTimeline:  []string{"skill:Frontend:React", "skill:Frontend:Go"},
+		},
+		{
+			name: "second rename collapses the redirect chain",
+			setup: func(t *testing.T, r *SkillsRepository) {
+				if _, err := r.RenameCategory("Frontend", "Web", false); err != nil {
+					t.Fatalf("RenameCategory() error = %v", err)
+				}
+			},
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.RenameCategory("Web", "UI", false)
+			},
+			wantMoves: []SkillMove{
+				{From: "skill:Web:React", To: "skill:UI:React"},
+				{From: "skill:Web:Vue", To: "skill:UI:Vue"},
+			},
+			wantCategories: []string{"UI", "Backend"},
+			wantPositions: map[string][]string{
+				"UI":      {"skill:UI:React", "skill:UI:Vue"},
+				"Backend": {"skill:Backend:Go"},
+			},
+			wantRedirects: map[string]string{
+				"skill:Frontend:React": "skill:UI:React",
+				"skill:Frontend:Vue":   "skill:UI:Vue",
+				"skill:Web:React":      "skill:UI:React",
+				"skill:Web:Vue":        "skill:UI:Vue",
+			},
+			wantTool:     "skill:UI:React",
+			wantTimeline: []string{"skill:UI:React", "skill:Backend:Go"},
+		},
+		{
+			name: "moving back removes the redirect from the live ID",
+			setup: func(t *testing.T, r *SkillsRepository) {
+				if _, err := r.MoveSkill("skill:Frontend:React", "Backend", false); err != nil {
+					t.Fatalf("MoveSkill() error = %v", err)
+				}
+			},
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.MoveSkill("skill:Backend:React", "Frontend", false)
+			},
+			wantMoves:      []SkillMove{{From: "skill:Backend:React", To: "skill:Frontend:React"}},
+			wantCategories: []string{"Frontend", "Backend"},
+			wantPositions: map[string][]string{
+				"Frontend": {"skill:Frontend:Vue", "skill:Frontend:React"},
+				"Backend":  {"skill:Backend:Go"},
+			},
+			wantRedirects: map[string]string{"skill:Backend:React": "skill:Frontend:React"},
+			wantTool:      "skill:Frontend:React",
+			wantTimeline:  []string{"skill:Frontend:React", "skill:Backend:Go"},
+		},
+		{
+			name: "dry run reports the plan without writing",
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.MergeCategories("Frontend", "Backend", true)
+			},
+			wantMoves: []SkillMove{
+				{From: "skill:Frontend:React", To: "skill:Backend:React"},
+				{From: "skill:Frontend:Vue", To: "skill:Backend:Vue"},
+			},
+			wantCategories: []string{"Frontend", "Backend"},
+			wantPositions: map[string][]string{
+				"Frontend": {"skill:Frontend:React", "skill:Frontend:Vue"},
+				"Backend":  {"skill:Backend:Go"},
+			},
+			wantRedirects: map[string]string{},
+			wantTool:      "skill:Frontend:React",
+			wantTimeline:  []string{"skill:Frontend:React", "skill:Backend:Go"},
+		},
+		{
+			name: "conflict in the target moves nothing",
+			setup: func(t *testing.T, r *SkillsRepository) {
+				if err := r.CreateSkill(NewSkill("Backend", "React", "")); err != nil {
+					t.Fatalf("CreateSkill() error = %v", err)
+				}
+			},
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.MergeCategories("Frontend", "Backend", false)
+			},
+			wantErr: ErrSkillConflict,
+			wantMoves: []SkillMove{
+				{From: "skill:Frontend:React", To: "skill:Backend:React"},
+				{From: "skill:Frontend:Vue", To: "skill:Backend:Vue"},
+			},
+			wantCategories: []string{"Frontend", "Backend"},
+			wantPositions: map[string][]string{
+				"Frontend": {"skill:Frontend:React", "skill:Frontend:Vue"},
+				"Backend":  {"skill:Backend:Go", "skill:Backend:React"},
+			},
+			wantRedirects: map[string]string{},
+			wantTool:      "skill:Frontend:React",
+			wantTimeline:  []string{"skill:Frontend:React", "skill:Backend:Go"},
+		},
+		{
+			name: "rename onto an existing category is rejected",
+			move: func(r *SkillsRepository) (*SkillMoveReport, error) {
+				return r.RenameCategory("Frontend", "Backend", false)
+			},
+			wantErr:        ErrCategoryExists,
+			wantCategories: []string{"Frontend", "Backend"},
+			wantPositions: map[string][]string{
+				"Frontend": {"skill:Frontend:React", "skill:Frontend:Vue"},
+				"Backend":  {"skill:Backend:Go"},
+			},
+			wantRedirects: map[string]string{},
+			wantTool:      "skill:Frontend:React",
+			wantTimeline:  []string{"skill:Frontend:React", "skill:Backend:Go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newSkillFixture(t)
+			if tt.setup != nil {
+				tt.setup(t, f.skills)
+			}
+
+			report, err := tt.move(f.skills)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantMoves != nil && !reflect.DeepEqual(report.Moves, tt.wantMoves) {
+				t.Errorf("moves = %v, want %v", report.Moves, tt.wantMoves)
+			}
+
+			categories, positions := f.state(t)
+			if !reflect.DeepEqual(categories, tt.wantCategories) {
+				t.Errorf("categories = %v, want %v", categories, tt.wantCategories)
+			}
+			if !reflect.DeepEqual(positions, tt.wantPositions) {
+				t.Errorf("positions = %v, want %v", positions, tt.wantPositions)
+			}
+			if redirects := f.redirects(t); !reflect.DeepEqual(redirects, tt.wantRedirects) {
+				t.Errorf("redirects = %v, want %v", redirects, tt.wantRedirects)
+			}
+
+			tool, timeline := f.references(t)
+			if tool != tt.wantTool {
+				t.Errorf("project tool = %q, want %q", tool, tt.wantTool)
+			}
+			if !reflect.DeepEqual(timeline, tt.wantTimeline) {
+				t.Errorf("timeline skills = %v, want %v", timeline, tt.wantTimeline)
+			}
+		})
+	}
+}
+
+func TestSkillMoveIndexes(t *testing.T) {
+	f := newSkillFixture(t)
+	if _, err := f.skills.MoveSkill("skill:Frontend:React", "Backend", false); err != nil {
+		t.Fatalf("MoveSkill() error = %v", err)
+	}
+
+	// Eski ID'nin key'i ve reverse index'leri yeni ID'ye taşınmış olmalı
+	if _, err := f.skills.GetSkillByID("skill:Frontend:React"); err == nil {
+		t.Error("old skill ID still exists")
+	}
+	skill, err := f.skills.GetSkillByID("skill:Backend:React")
+	if err != nil {
+		t.Fatalf("GetSkillByID() error = %v", err)
+	}
+	if skill.Category != "Backend" || skill.Icon != "/skills-upload/react.svg" {
+		t.Errorf("moved skill = %+v", skill)
+	}
+
+	projects, err := NewProjectsRepository(f.client).GetProjectsBySkill("skill:Backend:React")
+	if err != nil {
+		t.Fatalf("GetProjectsBySkill() error = %v", err)
+	}
+	if len(projects) != 1 || projects[0].ID != f.projectID {
+		t.Errorf("projects by moved skill = %v, want [%s]", projects, f.projectID)
+	}
+
+	for _, key := range []string{skillProjectsKey("skill:Frontend:React"), skillTimelineKey("skill:Frontend:React")} {
+		if n, _ := f.client.Exists(f.skills.ctx, key).Result(); n != 0 {
+			t.Errorf("%s still exists", key)
+		}
+	}
+	if members, _ := f.client.SMembers(f.skills.ctx, skillTimelineKey("skill:Backend:React")).Result(); !reflect.DeepEqual(members, []string{f.entryID}) {
+		t.Errorf("timeline index = %v, want [%s]", members, f.entryID)
+	}
+}
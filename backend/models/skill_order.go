@@ -0,0 +1,249 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// MaxSkillProficiency - Proficiency 1-5 arası (0 = belirtilmemiş)
+const MaxSkillProficiency = 5
+
+// Skill list sıralamaları (GET /skills?sort=)
+const (
+	SkillSortOrder       = "order"       // Kategori sırası, sonra kategori içi position (default)
+	SkillSortName        = "name"        // Skill adı (A-Z)
+	SkillSortProficiency = "proficiency" // Proficiency, yüksek önce
+	SkillSortExperience  = "experience"  // Deneyim yılı (ExperienceYears), fazla önce
+)
+
+// IsValidSkillSort - Desteklenen sıralama mı?
+func IsValidSkillSort(sortBy string) bool {
+	return sortBy == SkillSortOrder || sortBy == SkillSortName || sortBy == SkillSortProficiency || sortBy == SkillSortExperience
+}
+
+// Sıralama key'leri
+// "skills:category_order": kategori -> görünüm sırası
+// "skills:position:{category}": skill ID -> kategori içi sıra
+const skillCategoryOrderKey = "skills:category_order"
+
+func skillPositionKey(category string) string {
+	return "skills:position:" + category
+}
+
+// SkillQuery - GET /skills filtreleri ve sıralaması
+type SkillQuery struct {
+	Category       string // Boşsa tüm kategoriler
+	CurrentlyUsing *bool  // nil ise filtre yok
+	MinProficiency int    // 0 ise filtre yok
+	Sort           string // Boşsa SkillSortOrder
+}
+
+// GetSortedSkills - Filtrelenmiş ve sıralanmış skill'ler
+func (r *SkillsRepository) GetSortedSkills(query SkillQuery) ([]Skill, error) {
+	var skills []Skill
+	var err error
+	if query.Category != "" {
+		skills, err = r.GetSkillsByCategory(query.Category)
+	} else {
+		skills, err = r.GetAllSkills()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]Skill, 0, len(skills))
+	for _, skill := range skills {
+		if query.CurrentlyUsing != nil && skill.CurrentlyUsing != *query.CurrentlyUsing {
+			continue
+		}
+		if query.MinProficiency > 0 && skill.Proficiency < query.MinProficiency {
+			continue
+		}
+		filtered = append(filtered, skill)
+	}
+
+	// Diğer sıralamalarda eşitlik durumunda da görünüm sırası geçerli
+	if err := r.sortSkillsByOrder(filtered); err != nil {
+		return nil, err
+	}
+
+	switch query.Sort {
+	case SkillSortName:
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return strings.ToLower(filtered[i].Skill) < strings.ToLower(filtered[j].Skill)
+		})
+	case SkillSortProficiency:
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return filtered[i].Proficiency > filtered[j].Proficiency
+		})
+	case SkillSortExperience:
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return filtered[i].ExperienceYears() > filtered[j].ExperienceYears()
+		})
+	}
+
+	return filtered, nil
+}
+
+// SetSkillOrder - Kategori içi sırayı kaydet
+// Liste kategorideki tüm skill'leri tam olarak bir kez içermeli (kısmi sıralama kabul edilmez)
+func (r *SkillsRepository) SetSkillOrder(category string, skillIDs []string) error {
+	categoryIDs, err := r.client.SMembers(r.ctx, fmt.Sprintf("skills:category:%s", category)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get category skills: %w", err)
+	}
+	if len(categoryIDs) == 0 {
+		return fmt.Errorf("category not found: %s", category)
+	}
+	if err := checkCompleteOrder("skill", categoryIDs, skillIDs); err != nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, skillPositionKey(category))
+	for i, id := range skillIDs {
+		pipe.ZAdd(r.ctx, skillPositionKey(category), redis.Z{
+			Score:  float64(i),
+			Member: id,
+		})
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to save skill order: %w", err)
+	}
+	return nil
+}
+
+// SetCategoryOrder - Kategorilerin görünüm sırasını kaydet
+// Liste tüm kategorileri tam olarak bir kez içermeli
+func (r *SkillsRepository) SetCategoryOrder(categories []string) error {
+	allCategories, err := r.client.SMembers(r.ctx, "skills:categories").Result()
+	if err != nil {
+		return fmt.Errorf("failed to get categories: %w", err)
+	}
+	if err := checkCompleteOrder("category", allCategories, categories); err != nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, skillCategoryOrderKey)
+	for i, category := range categories {
+		pipe.ZAdd(r.ctx, skillCategoryOrderKey, redis.Z{
+			Score:  float64(i),
+			Member: category,
+		})
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to save category order: %w", err)
+	}
+	return nil
+}
+
+// sortSkillsByOrder - Kategori sırası, sonra kategori içi position
+// Sırası olmayan kategoriler ve skill'ler sona, kendi aralarında isme/ID'ye göre
+func (r *SkillsRepository) sortSkillsByOrder(skills []Skill) error {
+	categoryRanks, err := r.getScores(skillCategoryOrderKey)
+	if err != nil {
+		return err
+	}
+
+	positions := make(map[string]map[string]float64)
+	for _, skill := range skills {
+		if _, ok := positions[skill.Category]; ok {
+			continue
+		}
+		categoryPositions, err := r.getScores(skillPositionKey(skill.Category))
+		if err != nil {
+			return err
+		}
+		positions[skill.Category] = categoryPositions
+	}
+
+	sort.SliceStable(skills, func(i, j int) bool {
+		a, b := skills[i], skills[j]
+		if a.Category != b.Category {
+			return lessByScore(categoryRanks, a.Category, b.Category)
+		}
+		return lessByScore(positions[a.Category], a.ID, b.ID)
+	})
+	return nil
+}
+
+// sortCategories - Kategorileri görünüm sırasına göre sırala
+func (r *SkillsRepository) sortCategories(categories []string) error {
+	categoryRanks, err := r.getScores(skillCategoryOrderKey)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(categories, func(i, j int) bool {
+		return lessByScore(categoryRanks, categories[i], categories[j])
+	})
+	return nil
+}
+
+// getScores - Sorted set'i member -> score map'i olarak oku
+func (r *SkillsRepository) getScores(key string) (map[string]float64, error) {
+	entries, err := r.client.ZRangeWithScores(r.ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+
+	scores := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		scores[entry.Member.(string)] = entry.Score
+	}
+	return scores, nil
+}
+
+// nextScore - Sorted set'te sonraki boş sıra (boşsa 0)
+func (r *SkillsRepository) nextScore(key string) (float64, error) {
+	last, err := r.client.ZRevRangeWithScores(r.ctx, key, 0, 0).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	if len(last) == 0 {
+		return 0, nil
+	}
+	return last[0].Score + 1, nil
+}
+
+// lessByScore - Score'u olan önce, ikisinde de yoksa isim sırası
+func lessByScore(scores map[string]float64, a, b string) bool {
+	scoreA, okA := scores[a]
+	scoreB, okB := scores[b]
+	if okA != okB {
+		return okA
+	}
+	if okA && scoreA != scoreB {
+		return scoreA < scoreB
+	}
+	return a < b
+}
+
+// checkCompleteOrder - Sıra listesi mevcut elemanların tamamını tam bir kez içeriyor mu
+func checkCompleteOrder(kind string, all, ordered []string) error {
+	known := make(map[string]bool, len(all))
+	for _, item := range all {
+		known[item] = true
+	}
+	seen := make(map[string]bool, len(ordered))
+	for _, item := range ordered {
+		if !known[item] {
+			return fmt.Errorf("%s not found: %s", kind, item)
+		}
+		if seen[item] {
+			return fmt.Errorf("duplicate %s in order: %s", kind, item)
+		}
+		seen[item] = true
+	}
+	for _, item := range all {
+		if !seen[item] {
+			return fmt.Errorf("%s missing from order: %s", kind, item)
+		}
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"portfolio-backend/events"
+
 	"github.com/redis/go-redis/v9"
 )
 
@@ -55,7 +57,8 @@ func (r *SkillsRepository) CreateSkill(skill *Skill) error {
 		return fmt.Errorf("failed to update category skills: %w", err)
 	}
 
-	return nil
+	// Yeni skill kategorisinin, yeni kategori de kategorilerin sonuna eklenir
+	return r.appendPositions(skill)
 }
 
 // CreateMultipleSkills - Birden fazla skill ekle (Bulk operation)
@@ -64,6 +67,17 @@ func (r *SkillsRepository) CreateMultipleSkills(skills []Skill) error {
 	// JavaScript'teki Promise.all() gibi
 	pipe := r.client.Pipeline()
 
+	// Yeni skill'ler kategorisinin, yeni kategoriler kategori sırasının sonuna eklenir
+	categoryRanks, err := r.getScores(skillCategoryOrderKey)
+	if err != nil {
+		return err
+	}
+	nextRank, err := r.nextScore(skillCategoryOrderKey)
+	if err != nil {
+		return err
+	}
+	nextPositions := make(map[string]float64) // kategori -> sonraki position
+
 	for _, skill := range skills {
 		skillJSON, err := skill.ToJSON()
 		if err != nil {
@@ -76,14 +90,33 @@ func (r *SkillsRepository) CreateMultipleSkills(skills []Skill) error {
 		
 		categoryKey := fmt.Sprintf("skills:category:%s", skill.Category)
 		pipe.SAdd(r.ctx, categoryKey, skill.ID)
+
+		position, ok := nextPositions[skill.Category]
+		if !ok {
+			if position, err = r.nextScore(skillPositionKey(skill.Category)); err != nil {
+				return err
+			}
+		}
+		nextPositions[skill.Category] = position + 1
+		pipe.ZAddNX(r.ctx, skillPositionKey(skill.Category), redis.Z{Score: position, Member: skill.ID})
+
+		if _, ok := categoryRanks[skill.Category]; !ok {
+			categoryRanks[skill.Category] = nextRank
+			pipe.ZAdd(r.ctx, skillCategoryOrderKey, redis.Z{Score: nextRank, Member: skill.Category})
+			nextRank++
+		}
 	}
 
 	// Pipeline'ı execute et
-	_, err := pipe.Exec(r.ctx)
+	_, err = pipe.Exec(r.ctx)
 	if err != nil {
 		return fmt.Errorf("failed to execute skills pipeline: %w", err)
 	}
 
+	// Bulk yazımlar handler'dan geçmediği için event'leri repository yayınlar
+	for i := range skills {
+		events.Publish(events.SkillCreated, &skills[i])
+	}
 	return nil
 }
 
@@ -192,18 +225,26 @@ func (r *SkillsRepository) GetCategories() ([]string, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get categories: %w", err)
 	}
+
+	// Admin'in belirlediği görünüm sırası
+	if err := r.sortCategories(categories); err != nil {
+		return nil, err
+	}
 	return categories, nil
 }
 
 // UPDATE Operations
 
 // UpdateSkill - Skill'i güncelle
+// Kategori değişikliği ErrSkillCategoryChange döner: ID, referanslar ve redirect'ler MoveSkill ile yeniden yazılır
 func (r *SkillsRepository) UpdateSkill(skill *Skill) error {
-	// Önce mevcut skill'i al (kategori değişikliği için)
 	existingSkill, err := r.GetSkillByID(skill.ID)
 	if err != nil {
 		return fmt.Errorf("skill not found for update: %w", err)
 	}
+	if existingSkill.Category != skill.Category {
+		return fmt.Errorf("%w: %s (%s -> %s)", ErrSkillCategoryChange, skill.ID, existingSkill.Category, skill.Category)
+	}
 
 	// UpdatedAt'i güncelle
 	skill.UpdatedAt = time.Now()
@@ -219,18 +260,6 @@ func (r *SkillsRepository) UpdateSkill(skill *Skill) error {
 		return fmt.Errorf("failed to update skill in Redis: %w", err)
 	}
 
-	// Eğer kategori değiştiyse index'leri güncelle
-	if existingSkill.Category != skill.Category {
-		// Eski kategoriden çıkar
-		oldCategoryKey := fmt.Sprintf("skills:category:%s", existingSkill.Category)
-		r.client.SRem(r.ctx, oldCategoryKey, skill.ID)
-
-		// Yeni kategoriye ekle
-		r.client.SAdd(r.ctx, "skills:categories", skill.Category)
-		newCategoryKey := fmt.Sprintf("skills:category:%s", skill.Category)
-		r.client.SAdd(r.ctx, newCategoryKey, skill.ID)
-	}
-
 	return nil
 }
 
@@ -257,6 +286,11 @@ func (r *SkillsRepository) DeleteSkill(skillID string) error {
 		return fmt.Errorf("failed to remove from category index: %w", err)
 	}
 
+	err = r.client.ZRem(r.ctx, skillPositionKey(skill.Category), skillID).Err()
+	if err != nil {
+		return fmt.Errorf("failed to remove from skill order: %w", err)
+	}
+
 	return nil
 }
 
@@ -283,12 +317,13 @@ func (r *SkillsRepository) DeleteAllSkills() error {
 	// Index'leri temizle
 	pipe.Del(r.ctx, "skills:categories")
 	
-	// Kategori index'lerini temizle
+	// Kategori index'lerini ve sıralamaları temizle
 	categories, _ := r.GetCategories()
 	for _, category := range categories {
 		categoryKey := fmt.Sprintf("skills:category:%s", category)
-		pipe.Del(r.ctx, categoryKey)
+		pipe.Del(r.ctx, categoryKey, skillPositionKey(category))
 	}
+	pipe.Del(r.ctx, skillCategoryOrderKey)
 
 	_, err = pipe.Exec(r.ctx)
 	if err != nil {
@@ -298,22 +333,104 @@ func (r *SkillsRepository) DeleteAllSkills() error {
 	return nil
 }
 
+// RebuildIndexes - Kategori index'lerini skill verisinden yeniden oluştur
+// Bulk import sonrası boş kategoriler ve eski üyelikler temizlenir
+// Mevcut sıralama korunur, sırası olmayan skill/kategoriler sona eklenir
+func (r *SkillsRepository) RebuildIndexes() error {
+	skills, err := r.GetAllSkills()
+	if err != nil {
+		return err
+	}
+	if err := r.sortSkillsByOrder(skills); err != nil {
+		return err
+	}
+
+	categoryKeys, err := r.client.Keys(r.ctx, "skills:category:*").Result()
+	if err != nil {
+		return fmt.Errorf("failed to get category indexes: %w", err)
+	}
+	positionKeys, err := r.client.Keys(r.ctx, skillPositionKey("*")).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get skill order indexes: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, append(append(categoryKeys, positionKeys...), "skills:categories", skillCategoryOrderKey)...)
+
+	// Skill'ler sıralı geldiği için position'lar ve kategori sırası baştan numaralanır
+	positions := make(map[string]float64)
+	var categories []string
+	for _, skill := range skills {
+		pipe.SAdd(r.ctx, "skills:categories", skill.Category)
+		pipe.SAdd(r.ctx, fmt.Sprintf("skills:category:%s", skill.Category), skill.ID)
+
+		position, ok := positions[skill.Category]
+		if !ok {
+			pipe.ZAdd(r.ctx, skillCategoryOrderKey, redis.Z{
+				Score:  float64(len(positions)),
+				Member: skill.Category,
+			})
+			categories = append(categories, skill.Category)
+		}
+		pipe.ZAdd(r.ctx, skillPositionKey(skill.Category), redis.Z{
+			Score:  position,
+			Member: skill.ID,
+		})
+		positions[skill.Category] = position + 1
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to rebuild skill indexes: %w", err)
+	}
+
+	// Sıralar baştan numaralandı, dinleyiciler kategori sırasını yeniden okumalı
+	events.Publish(events.SkillsReordered, categories)
+	return nil
+}
+
 // Helper Methods
 
+// SkillKeyPrefix - Skill ID'lerinin (ve Redis key'lerinin) prefix'i
+// getAllSkillIDs bu prefix'le başlayan her key'i skill sayar; index, sıralama ve
+// migration key'leri "skills:" veya kendi prefix'lerini kullanmalı
+const SkillKeyPrefix = "skill:"
+
 // getAllSkillIDs - Tüm skill ID'lerini getir
 func (r *SkillsRepository) getAllSkillIDs() ([]string, error) {
-	// Redis'te "skill:*" pattern'ine uyan tüm key'leri bul
-	skillIDs, err := r.client.Keys(r.ctx, "skill:*").Result()
+	// Redis'te SkillKeyPrefix ile başlayan tüm key'leri bul
+	skillIDs, err := r.client.Keys(r.ctx, SkillKeyPrefix+"*").Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get skill IDs: %w", err)
 	}
 	return skillIDs, nil
 }
 
+// appendPositions - Skill'i kategorisinin, kategori yeniyse kategoriyi de sıranın sonuna ekle
+// Zaten sırası olanlar değişmez
+func (r *SkillsRepository) appendPositions(skill *Skill) error {
+	position, err := r.nextScore(skillPositionKey(skill.Category))
+	if err != nil {
+		return err
+	}
+	rank, err := r.nextScore(skillCategoryOrderKey)
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.ZAddNX(r.ctx, skillPositionKey(skill.Category), redis.Z{Score: position, Member: skill.ID})
+	pipe.ZAddNX(r.ctx, skillCategoryOrderKey, redis.Z{Score: rank, Member: skill.Category})
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to update skill order: %w", err)
+	}
+	return nil
+}
+
 // GetSkillsResponse - V1 API format'ında response hazırla
-func (r *SkillsRepository) GetSkillsResponse() (*SkillsResponse, error) {
-	// Tüm skilleri al
-	skills, err := r.GetAllSkills()
+// Skill'ler query'ye göre filtrelenir ve sıralanır, kategoriler her zaman tam liste (görünüm sırasında)
+func (r *SkillsRepository) GetSkillsResponse(query SkillQuery) (*SkillsResponse, error) {
+	// Skilleri al
+	skills, err := r.GetSortedSkills(query)
 	if err != nil {
 		return nil, err
 	}
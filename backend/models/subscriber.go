@@ -0,0 +1,74 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Subscriber status'ları
+// pending -> confirm linkine tıklanınca confirmed -> unsubscribe ile unsubscribed
+const (
+	SubscriberPending      = "pending"
+	SubscriberConfirmed    = "confirmed"
+	SubscriberUnsubscribed = "unsubscribed"
+)
+
+// Subscriber - Newsletter abonesi
+type Subscriber struct {
+	ID             string     `json:"id"`                        // "subscriber:jane@example.com"
+	Email          string     `json:"email"`                     // Lowercase e-posta
+	Status         string     `json:"status"`                    // pending, confirmed, unsubscribed
+	CreatedAt      time.Time  `json:"created_at"`                // İlk abonelik isteği
+	ConfirmedAt    *time.Time `json:"confirmed_at,omitempty"`    // Double opt-in onayı
+	UnsubscribedAt *time.Time `json:"unsubscribed_at,omitempty"` // Abonelikten çıkış
+}
+
+// NewSubscriber - Yeni pending abone oluşturucu
+func NewSubscriber(email string) *Subscriber {
+	email = NormalizeEmail(email)
+	return &Subscriber{
+		ID:        generateSubscriberID(email),
+		Email:     email,
+		Status:    SubscriberPending,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NormalizeEmail - Aynı adres iki kez kaydolmasın diye lowercase + trim
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// generateSubscriberID - Redis key format: "subscriber:jane@example.com"
+func generateSubscriberID(email string) string {
+	return "subscriber:" + email
+}
+
+// Confirm - Double opt-in onayı
+func (s *Subscriber) Confirm() {
+	now := time.Now()
+	s.Status = SubscriberConfirmed
+	s.ConfirmedAt = &now
+	s.UnsubscribedAt = nil
+}
+
+// Unsubscribe - Abonelikten çık
+func (s *Subscriber) Unsubscribe() {
+	now := time.Now()
+	s.Status = SubscriberUnsubscribed
+	s.UnsubscribedAt = &now
+}
+
+// ToJSON ve FromJSON methodları
+func (s *Subscriber) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (s *Subscriber) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), s)
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SubscribersRepository - Newsletter aboneleri için CRUD operations
+type SubscribersRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewSubscribersRepository - Repository oluştur
+func NewSubscribersRepository(client *redis.Client) *SubscribersRepository {
+	return &SubscribersRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// SaveSubscriber - Aboneyi kaydet (create veya update)
+// Status index'i: "newsletter:subscribers:status:confirmed"
+func (r *SubscribersRepository) SaveSubscriber(subscriber *Subscriber) error {
+	subscriberJSON, err := subscriber.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal subscriber: %w", err)
+	}
+
+	pipe := r.client.Pipeline()
+
+	// Abone verisi (expire yok - abonelik kalıcı)
+	pipe.Set(r.ctx, subscriber.ID, subscriberJSON, 0)
+	pipe.SAdd(r.ctx, "newsletter:subscribers:all", subscriber.ID)
+
+	// Status index'leri - abone tek bir status set'inde bulunur
+	for _, status := range []string{SubscriberPending, SubscriberConfirmed, SubscriberUnsubscribed} {
+		statusKey := fmt.Sprintf("newsletter:subscribers:status:%s", status)
+		if status == subscriber.Status {
+			pipe.SAdd(r.ctx, statusKey, subscriber.ID)
+		} else {
+			pipe.SRem(r.ctx, statusKey, subscriber.ID)
+		}
+	}
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save subscriber: %w", err)
+	}
+
+	return nil
+}
+
+// GetSubscriberByEmail - E-postaya göre abone getir
+func (r *SubscribersRepository) GetSubscriberByEmail(email string) (*Subscriber, error) {
+	subscriberID := generateSubscriberID(NormalizeEmail(email))
+
+	subscriberJSON, err := r.client.Get(r.ctx, subscriberID).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("subscriber not found: %s", email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriber: %w", err)
+	}
+
+	var subscriber Subscriber
+	err = subscriber.FromJSON(subscriberJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal subscriber: %w", err)
+	}
+
+	return &subscriber, nil
+}
+
+// GetSubscribers - Status'a göre aboneler (boş status = hepsi)
+func (r *SubscribersRepository) GetSubscribers(status string) ([]Subscriber, error) {
+	key := "newsletter:subscribers:all"
+	if status != "" {
+		key = fmt.Sprintf("newsletter:subscribers:status:%s", status)
+	}
+
+	subscriberIDs, err := r.client.SMembers(r.ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriber IDs: %w", err)
+	}
+
+	if len(subscriberIDs) == 0 {
+		return []Subscriber{}, nil
+	}
+
+	subscriberJSONs, err := r.client.MGet(r.ctx, subscriberIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscribers: %w", err)
+	}
+
+	subscribers := make([]Subscriber, 0, len(subscriberJSONs))
+	for i, subscriberJSON := range subscriberJSONs {
+		if subscriberJSON == nil {
+			continue
+		}
+
+		var subscriber Subscriber
+		err = subscriber.FromJSON(subscriberJSON.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal subscriber %s: %w", subscriberIDs[i], err)
+		}
+
+		subscribers = append(subscribers, subscriber)
+	}
+
+	// En yeni abone first
+	sort.Slice(subscribers, func(i, j int) bool {
+		return subscribers[i].CreatedAt.After(subscribers[j].CreatedAt)
+	})
+
+	return subscribers, nil
+}
+
+// DeleteSubscriber - Aboneyi tamamen sil (GDPR talebi vs)
+func (r *SubscribersRepository) DeleteSubscriber(email string) error {
+	subscriber, err := r.GetSubscriberByEmail(email)
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.Del(r.ctx, subscriber.ID)
+	pipe.SRem(r.ctx, "newsletter:subscribers:all", subscriber.ID)
+	statusKey := fmt.Sprintf("newsletter:subscribers:status:%s", subscriber.Status)
+	pipe.SRem(r.ctx, statusKey, subscriber.ID)
+
+	_, err = pipe.Exec(r.ctx)
+	return err
+}
+
+// MarkPostNotified - Post için bildirim gönderildi mi? (bir kez gönderilsin)
+// false dönerse bu post için daha önce bildirim yapılmış demektir
+func (r *SubscribersRepository) MarkPostNotified(postID string) (bool, error) {
+	added, err := r.client.SAdd(r.ctx, "newsletter:notified_posts", postID).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark post notified: %w", err)
+	}
+	return added == 1, nil
+}
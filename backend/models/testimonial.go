@@ -0,0 +1,179 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Testimonial status'ları
+// Davet linkiyle gelen her testimonial pending başlar, admin onaylar veya reddeder
+const (
+	TestimonialPending  = "pending"
+	TestimonialApproved = "approved"
+	TestimonialRejected = "rejected"
+)
+
+// TestimonialStatuses - Geçerli status listesi (validation ve index temizliği için)
+var TestimonialStatuses = []string{TestimonialPending, TestimonialApproved, TestimonialRejected}
+
+// IsValidTestimonialStatus - Status geçerli mi?
+func IsValidTestimonialStatus(status string) bool {
+	for _, s := range TestimonialStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// TestimonialProject - Bağlı proje (okuma sırasında projeden doldurulur)
+type TestimonialProject struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug,omitempty"`
+}
+
+// Testimonial - İş arkadaşı veya müşteri referansı
+type Testimonial struct {
+	ID         string              `json:"id"`                    // "testimonial:3f9a1c2b7d4e"
+	Author     string              `json:"author"`                // Yazan kişi
+	Role       string              `json:"role,omitempty"`        // "Engineering Manager"
+	Company    string              `json:"company,omitempty"`     // "Acme"
+	Avatar     string              `json:"avatar,omitempty"`      // "/uploads/testimonials/3f9a1c2b7d4e.jpg"
+	Quote      string              `json:"quote"`                 // Düz metin
+	ProjectID  string              `json:"project_id,omitempty"`  // Opsiyonel "project:..." linki
+	Project    *TestimonialProject `json:"project,omitempty"`     // ProjectID'den, kaydedilmez
+	Status     string              `json:"status"`                // pending, approved, rejected
+	Featured   bool                `json:"featured"`              // Sadece approved olanlar
+	InviteID   string              `json:"invite_id,omitempty"`   // Hangi davetle geldi
+	CreatedAt  time.Time           `json:"created_at"`            // Gönderim zamanı
+	UpdatedAt  time.Time           `json:"updated_at"`            // Son düzenleme
+	ReviewedAt *time.Time          `json:"reviewed_at,omitempty"` // Son onay/red zamanı
+}
+
+// TestimonialsResponse - Liste response'u
+type TestimonialsResponse struct {
+	Count        int           `json:"count"`
+	Testimonials []Testimonial `json:"testimonials"`
+}
+
+// NewTestimonial - Yeni pending testimonial oluşturucu
+func NewTestimonial(author, role, company, quote, projectID string) *Testimonial {
+	now := time.Now()
+	return &Testimonial{
+		ID:        "testimonial:" + randomHex(6),
+		Author:    strings.TrimSpace(author),
+		Role:      strings.TrimSpace(role),
+		Company:   strings.TrimSpace(company),
+		Quote:     strings.TrimSpace(quote),
+		ProjectID: strings.TrimSpace(projectID),
+		Status:    TestimonialPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate - Zorunlu alanlar ve proje ID formatı
+func (t *Testimonial) Validate() error {
+	if strings.TrimSpace(t.Author) == "" {
+		return fmt.Errorf("author is required")
+	}
+	if strings.TrimSpace(t.Quote) == "" {
+		return fmt.Errorf("quote is required")
+	}
+	if t.ProjectID != "" && !strings.HasPrefix(t.ProjectID, "project:") {
+		return fmt.Errorf("invalid project_id: %s", t.ProjectID)
+	}
+	if !IsValidTestimonialStatus(t.Status) {
+		return fmt.Errorf("invalid status: %q (%s)", t.Status, strings.Join(TestimonialStatuses, ", "))
+	}
+	return nil
+}
+
+// SetStatus - Onay/red, approved olmayan testimonial öne çıkarılamaz
+func (t *Testimonial) SetStatus(status string) {
+	now := time.Now()
+	t.Status = status
+	t.ReviewedAt = &now
+	t.UpdatedAt = now
+	if status != TestimonialApproved {
+		t.Featured = false
+	}
+}
+
+// AvatarName - Avatar dosya adı (uzantısız): ID'nin hex kısmı
+func (t *Testimonial) AvatarName() string {
+	return strings.TrimPrefix(t.ID, "testimonial:")
+}
+
+// ToJSON - JSON string'e çevir (Project kaydedilmez, okumada yeniden doldurulur)
+func (t *Testimonial) ToJSON() (string, error) {
+	stored := *t
+	stored.Project = nil
+	jsonBytes, err := json.Marshal(stored)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// FromJSON - JSON string'den struct oluştur
+func (t *Testimonial) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), t)
+}
+
+// TestimonialInvite - Tek kullanımlık testimonial davet linki
+// Token linkte gider, ID admin işlemleri için
+type TestimonialInvite struct {
+	ID            string     `json:"id"`                       // "testimonial_invite:3f9a1c2b7d4e"
+	Token         string     `json:"token"`                    // 32 byte hex, sadece admin görür
+	Name          string     `json:"name"`                     // Davet edilen kişi (form'da author olarak önerilir)
+	Email         string     `json:"email,omitempty"`          // Admin'in kaydı için, gönderilmez
+	ProjectID     string     `json:"project_id,omitempty"`     // Testimonial bu projeye bağlanır
+	Note          string     `json:"note,omitempty"`           // Admin notu
+	ExpiresAt     time.Time  `json:"expires_at"`               // Bu zamandan sonra kullanılamaz
+	UsedAt        *time.Time `json:"used_at,omitempty"`        // Testimonial gönderildiğinde
+	TestimonialID string     `json:"testimonial_id,omitempty"` // Gönderilen testimonial
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// NewTestimonialInvite - Yeni davet oluşturucu (ttl sonra geçersiz)
+func NewTestimonialInvite(name, email, projectID, note string, ttl time.Duration) *TestimonialInvite {
+	now := time.Now()
+	return &TestimonialInvite{
+		ID:        "testimonial_invite:" + randomHex(6),
+		Token:     randomHex(32),
+		Name:      strings.TrimSpace(name),
+		Email:     NormalizeEmail(email),
+		ProjectID: strings.TrimSpace(projectID),
+		Note:      strings.TrimSpace(note),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
+
+// Expired - Davetin süresi doldu mu?
+func (i *TestimonialInvite) Expired() bool {
+	return time.Now().After(i.ExpiresAt)
+}
+
+// Used - Davetle testimonial gönderildi mi?
+func (i *TestimonialInvite) Used() bool {
+	return i.UsedAt != nil
+}
+
+// ToJSON - JSON string'e çevir
+func (i *TestimonialInvite) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(i)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// FromJSON - JSON string'den struct oluştur
+func (i *TestimonialInvite) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), i)
+}
@@ -0,0 +1,485 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrInvalidInvite - Token hiçbir davete ait değil
+	ErrInvalidInvite = errors.New("invalid invite")
+	// ErrInviteExpired - Davetin süresi dolmuş
+	ErrInviteExpired = errors.New("invite expired")
+	// ErrInviteUsed - Davetle zaten testimonial gönderilmiş
+	ErrInviteUsed = errors.New("invite already used")
+	// ErrUnknownProject - Bağlanmak istenen proje yok
+	ErrUnknownProject = errors.New("unknown project")
+	// ErrTestimonialNotApproved - Sadece approved testimonial öne çıkarılabilir
+	ErrTestimonialNotApproved = errors.New("testimonial is not approved")
+)
+
+// Testimonial index key'leri ("testimonial:" ID'leri ile karışmasın diye "testimonials:")
+// "testimonials:by_date": tüm testimonial'lar, score = gönderim zamanı
+// "testimonials:status:{status}": status index'i
+// "testimonials:invites:by_date": davetler, score = oluşturma zamanı
+// "testimonials:invite_tokens": token -> davet ID hash'i
+const (
+	testimonialsByDateKey = "testimonials:by_date"
+	testimonialInvitesKey = "testimonials:invites:by_date"
+	testimonialTokensKey  = "testimonials:invite_tokens"
+)
+
+func testimonialStatusKey(status string) string {
+	return "testimonials:status:" + status
+}
+
+// TestimonialsRepository - Testimonial ve davetler için CRUD operations
+type TestimonialsRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewTestimonialsRepository - Yeni repository oluştur
+func NewTestimonialsRepository(client *redis.Client) *TestimonialsRepository {
+	return &TestimonialsRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// Invite Operations
+
+// CreateInvite - Yeni davet kaydet (proje varsa var olmalı)
+func (r *TestimonialsRepository) CreateInvite(invite *TestimonialInvite) error {
+	if err := r.checkProject(invite.ProjectID); err != nil {
+		return err
+	}
+
+	inviteJSON, err := invite.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal testimonial invite: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(r.ctx, invite.ID, inviteJSON, time.Hour*24*365)
+	pipe.ZAdd(r.ctx, testimonialInvitesKey, redis.Z{Score: float64(invite.CreatedAt.UnixNano()), Member: invite.ID})
+	pipe.HSet(r.ctx, testimonialTokensKey, invite.Token, invite.ID)
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to save testimonial invite: %w", err)
+	}
+	return nil
+}
+
+// GetInviteByID - ID'ye göre davet
+func (r *TestimonialsRepository) GetInviteByID(inviteID string) (*TestimonialInvite, error) {
+	if !strings.HasPrefix(inviteID, "testimonial_invite:") {
+		return nil, fmt.Errorf("testimonial invite not found: %s", inviteID)
+	}
+	return r.readInvite(r.client, inviteID)
+}
+
+// GetInviteByToken - Kullanılabilir davet (ErrInvalidInvite, ErrInviteExpired, ErrInviteUsed)
+func (r *TestimonialsRepository) GetInviteByToken(token string) (*TestimonialInvite, error) {
+	inviteID, err := r.inviteIDForToken(token)
+	if err != nil {
+		return nil, err
+	}
+	invite, err := r.readInvite(r.client, inviteID)
+	if err != nil {
+		return nil, ErrInvalidInvite
+	}
+	if err := checkInvite(invite); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+// GetInvites - Tüm davetler, en yeni önce
+func (r *TestimonialsRepository) GetInvites() ([]TestimonialInvite, error) {
+	inviteIDs, err := r.client.ZRevRange(r.ctx, testimonialInvitesKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonial invite IDs: %w", err)
+	}
+
+	invites := make([]TestimonialInvite, 0, len(inviteIDs))
+	if len(inviteIDs) == 0 {
+		return invites, nil
+	}
+
+	inviteJSONs, err := r.client.MGet(r.ctx, inviteIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonial invites from Redis: %w", err)
+	}
+	for i, inviteJSON := range inviteJSONs {
+		if inviteJSON == nil {
+			continue
+		}
+		var invite TestimonialInvite
+		if err := invite.FromJSON(inviteJSON.(string)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal testimonial invite %s: %w", inviteIDs[i], err)
+		}
+		invites = append(invites, invite)
+	}
+	return invites, nil
+}
+
+// DeleteInvite - Daveti ve token'ını sil (gönderilmiş testimonial kalır)
+func (r *TestimonialsRepository) DeleteInvite(inviteID string) error {
+	invite, err := r.GetInviteByID(inviteID)
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, invite.ID)
+	pipe.ZRem(r.ctx, testimonialInvitesKey, invite.ID)
+	pipe.HDel(r.ctx, testimonialTokensKey, invite.Token)
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to delete testimonial invite: %w", err)
+	}
+	return nil
+}
+
+// Testimonial Operations
+
+// SubmitTestimonial - Davet token'ı ile pending testimonial kaydet, daveti kullanılmış işaretle
+// Davette proje varsa testimonial o projeye bağlanır
+// Aynı token ile eşzamanlı iki gönderimden sadece biri kabul edilir
+func (r *TestimonialsRepository) SubmitTestimonial(token string, testimonial *Testimonial) error {
+	inviteID, err := r.inviteIDForToken(token)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Watch(r.ctx, func(tx *redis.Tx) error {
+		invite, err := r.readInvite(tx, inviteID)
+		if err != nil {
+			return ErrInvalidInvite
+		}
+		if err := checkInvite(invite); err != nil {
+			return err
+		}
+
+		if invite.ProjectID != "" {
+			testimonial.ProjectID = invite.ProjectID
+		}
+		if err := r.checkProject(testimonial.ProjectID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		testimonial.Status = TestimonialPending
+		testimonial.InviteID = invite.ID
+		invite.UsedAt = &now
+		invite.TestimonialID = testimonial.ID
+
+		testimonialJSON, err := testimonial.ToJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal testimonial: %w", err)
+		}
+		inviteJSON, err := invite.ToJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal testimonial invite: %w", err)
+		}
+
+		_, err = tx.TxPipelined(r.ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(r.ctx, testimonial.ID, testimonialJSON, time.Hour*24*365)
+			pipe.ZAdd(r.ctx, testimonialsByDateKey, redis.Z{Score: float64(testimonial.CreatedAt.UnixNano()), Member: testimonial.ID})
+			pipe.SAdd(r.ctx, testimonialStatusKey(testimonial.Status), testimonial.ID)
+			pipe.Set(r.ctx, invite.ID, inviteJSON, redis.KeepTTL)
+			return nil
+		})
+		return err
+	}, inviteID)
+	if err == redis.TxFailedErr {
+		return ErrInviteUsed
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.resolveProjects([]*Testimonial{testimonial})
+}
+
+// GetTestimonialByID - ID'ye göre testimonial
+func (r *TestimonialsRepository) GetTestimonialByID(testimonialID string) (*Testimonial, error) {
+	if !strings.HasPrefix(testimonialID, "testimonial:") {
+		return nil, fmt.Errorf("testimonial not found: %s", testimonialID)
+	}
+
+	testimonialJSON, err := r.client.Get(r.ctx, testimonialID).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("testimonial not found: %s", testimonialID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonial: %w", err)
+	}
+
+	var testimonial Testimonial
+	if err := testimonial.FromJSON(testimonialJSON); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal testimonial: %w", err)
+	}
+	if err := r.resolveProjects([]*Testimonial{&testimonial}); err != nil {
+		return nil, err
+	}
+	return &testimonial, nil
+}
+
+// GetTestimonials - Testimonial'lar, en yeni önce (status boşsa hepsi)
+func (r *TestimonialsRepository) GetTestimonials(status string) ([]Testimonial, error) {
+	testimonialIDs, err := r.client.ZRevRange(r.ctx, testimonialsByDateKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonial IDs: %w", err)
+	}
+
+	// Status filtresi - sıralamayı korumak için sorted set üzerinden filtrele
+	if status != "" {
+		members, err := r.client.SMembers(r.ctx, testimonialStatusKey(status)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get testimonial status index: %w", err)
+		}
+
+		inStatus := make(map[string]bool, len(members))
+		for _, id := range members {
+			inStatus[id] = true
+		}
+
+		filtered := make([]string, 0, len(members))
+		for _, id := range testimonialIDs {
+			if inStatus[id] {
+				filtered = append(filtered, id)
+			}
+		}
+		testimonialIDs = filtered
+	}
+
+	return r.getTestimonialsByIDs(testimonialIDs)
+}
+
+// GetApprovedTestimonials - Public liste: approved olanlar, öne çıkanlar ve en yeniler önce
+// featuredOnly sadece öne çıkanları, projectID sadece o projeye bağlı olanları döndürür
+func (r *TestimonialsRepository) GetApprovedTestimonials(featuredOnly bool, projectID string) ([]Testimonial, error) {
+	testimonials, err := r.GetTestimonials(TestimonialApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]Testimonial, 0, len(testimonials))
+	for _, testimonial := range testimonials {
+		if featuredOnly && !testimonial.Featured {
+			continue
+		}
+		if projectID != "" && testimonial.ProjectID != projectID {
+			continue
+		}
+		filtered = append(filtered, testimonial)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Featured && !filtered[j].Featured
+	})
+	return filtered, nil
+}
+
+// UpdateTestimonial - Testimonial'ı kaydet (status değiştiyse index de taşınır)
+// Proje değiştiyse yeni proje var olmalı (yoksa ErrUnknownProject)
+func (r *TestimonialsRepository) UpdateTestimonial(testimonial *Testimonial) error {
+	previous, err := r.GetTestimonialByID(testimonial.ID)
+	if err != nil {
+		return err
+	}
+	if testimonial.ProjectID != previous.ProjectID {
+		if err := r.checkProject(testimonial.ProjectID); err != nil {
+			return err
+		}
+	}
+	if testimonial.Featured && testimonial.Status != TestimonialApproved {
+		return ErrTestimonialNotApproved
+	}
+
+	testimonial.UpdatedAt = time.Now()
+	testimonialJSON, err := testimonial.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal testimonial: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(r.ctx, testimonial.ID, testimonialJSON, time.Hour*24*365)
+	if previous.Status != testimonial.Status {
+		pipe.SRem(r.ctx, testimonialStatusKey(previous.Status), testimonial.ID)
+		pipe.SAdd(r.ctx, testimonialStatusKey(testimonial.Status), testimonial.ID)
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to update testimonial: %w", err)
+	}
+
+	return r.resolveProjects([]*Testimonial{testimonial})
+}
+
+// DeleteTestimonial - Testimonial'ı ve index'lerini sil
+func (r *TestimonialsRepository) DeleteTestimonial(testimonialID string) error {
+	testimonial, err := r.GetTestimonialByID(testimonialID)
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, testimonial.ID)
+	pipe.ZRem(r.ctx, testimonialsByDateKey, testimonial.ID)
+	pipe.SRem(r.ctx, testimonialStatusKey(testimonial.Status), testimonial.ID)
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to delete testimonial: %w", err)
+	}
+	return nil
+}
+
+// Helper Methods
+
+// inviteIDForToken - Token'a ait davet ID'si (yoksa ErrInvalidInvite)
+func (r *TestimonialsRepository) inviteIDForToken(token string) (string, error) {
+	if token == "" {
+		return "", ErrInvalidInvite
+	}
+	inviteID, err := r.client.HGet(r.ctx, testimonialTokensKey, token).Result()
+	if err == redis.Nil {
+		return "", ErrInvalidInvite
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get testimonial invite token: %w", err)
+	}
+	return inviteID, nil
+}
+
+// readInvite - Daveti oku (client veya transaction içinden)
+func (r *TestimonialsRepository) readInvite(reader redis.Cmdable, inviteID string) (*TestimonialInvite, error) {
+	inviteJSON, err := reader.Get(r.ctx, inviteID).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("testimonial invite not found: %s", inviteID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonial invite: %w", err)
+	}
+
+	var invite TestimonialInvite
+	if err := invite.FromJSON(inviteJSON); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal testimonial invite: %w", err)
+	}
+	return &invite, nil
+}
+
+// checkInvite - Kullanılmış veya süresi dolmuş davet kabul edilmez
+func checkInvite(invite *TestimonialInvite) error {
+	if invite.Used() {
+		return ErrInviteUsed
+	}
+	if invite.Expired() {
+		return ErrInviteExpired
+	}
+	return nil
+}
+
+// checkProject - Proje ID'si boş veya var olan bir proje mi?
+func (r *TestimonialsRepository) checkProject(projectID string) error {
+	if projectID == "" {
+		return nil
+	}
+	if !strings.HasPrefix(projectID, "project:") {
+		return fmt.Errorf("%w: %s", ErrUnknownProject, projectID)
+	}
+	exists, err := r.client.Exists(r.ctx, projectID).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check project: %w", err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownProject, projectID)
+	}
+	return nil
+}
+
+// getTestimonialsByIDs - Testimonial'ları ID sırasıyla oku (silinmiş olanlar atlanır)
+func (r *TestimonialsRepository) getTestimonialsByIDs(testimonialIDs []string) ([]Testimonial, error) {
+	testimonials := make([]Testimonial, 0, len(testimonialIDs))
+	if len(testimonialIDs) == 0 {
+		return testimonials, nil
+	}
+
+	testimonialJSONs, err := r.client.MGet(r.ctx, testimonialIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonials from Redis: %w", err)
+	}
+
+	for i, testimonialJSON := range testimonialJSONs {
+		if testimonialJSON == nil {
+			continue
+		}
+		var testimonial Testimonial
+		if err := testimonial.FromJSON(testimonialJSON.(string)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal testimonial %s: %w", testimonialIDs[i], err)
+		}
+		testimonials = append(testimonials, testimonial)
+	}
+
+	refs := make([]*Testimonial, len(testimonials))
+	for i := range testimonials {
+		refs[i] = &testimonials[i]
+	}
+	if err := r.resolveProjects(refs); err != nil {
+		return nil, err
+	}
+	return testimonials, nil
+}
+
+// resolveProjects - Project alanını güncel proje başlığı/slug'ıyla doldur
+// Silinmiş projeler gösterilmez (ProjectID kalır)
+func (r *TestimonialsRepository) resolveProjects(testimonials []*Testimonial) error {
+	var projectIDs []string
+	seen := make(map[string]bool)
+	for _, testimonial := range testimonials {
+		testimonial.Project = nil
+		if testimonial.ProjectID != "" && !seen[testimonial.ProjectID] {
+			seen[testimonial.ProjectID] = true
+			projectIDs = append(projectIDs, testimonial.ProjectID)
+		}
+	}
+	if len(projectIDs) == 0 {
+		return nil
+	}
+
+	projectJSONs, err := r.client.MGet(r.ctx, projectIDs...).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get testimonial projects from Redis: %w", err)
+	}
+
+	projects := make(map[string]*TestimonialProject, len(projectIDs))
+	for i, projectJSON := range projectJSONs {
+		if projectJSON == nil {
+			continue
+		}
+		var project Project
+		if err := project.FromJSON(projectJSON.(string)); err != nil {
+			return fmt.Errorf("failed to unmarshal project %s: %w", projectIDs[i], err)
+		}
+		projects[projectIDs[i]] = &TestimonialProject{
+			ID:    projectIDs[i],
+			Title: project.Title,
+			Slug:  project.Slug,
+		}
+	}
+
+	for _, testimonial := range testimonials {
+		if project, ok := projects[testimonial.ProjectID]; ok {
+			testimonial.Project = project
+		}
+	}
+	return nil
+}
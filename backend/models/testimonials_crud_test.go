@@ -0,0 +1,164 @@
+package models
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubmitTestimonial(t *testing.T) {
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		prepare func(t *testing.T, r *TestimonialsRepository, invite *TestimonialInvite)
+		token   func(invite *TestimonialInvite) string
+		// Aynı token ile sırayla yapılan gönderimlerin sonuçları
+		wantErrs []error
+	}{
+		{
+			name:     "first submission is accepted",
+			wantErrs: []error{nil},
+		},
+		{
+			name:     "invite can only be used once",
+			wantErrs: []error{nil, ErrInviteUsed, ErrInviteUsed},
+		},
+		{
+			name:     "expired invite is rejected",
+			ttl:      -time.Minute,
+			wantErrs: []error{ErrInviteExpired},
+		},
+		{
+			name:     "unknown token is rejected",
+			token:    func(*TestimonialInvite) string { return "not-a-token" },
+			wantErrs: []error{ErrInvalidInvite},
+		},
+		{
+			name:     "empty token is rejected",
+			token:    func(*TestimonialInvite) string { return "" },
+			wantErrs: []error{ErrInvalidInvite},
+		},
+		{
+			name: "deleted invite is rejected",
+			prepare: func(t *testing.T, r *TestimonialsRepository, invite *TestimonialInvite) {
+				if err := r.DeleteInvite(invite.ID); err != nil {
+					t.Fatalf("DeleteInvite() error = %v", err)
+				}
+			},
+			wantErrs: []error{ErrInvalidInvite},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, client := newTestRedis(t)
+			r := NewTestimonialsRepository(client)
+
+			ttl := tt.ttl
+			if ttl == 0 {
+				ttl = time.Hour
+			}
+			invite := NewTestimonialInvite("Jane Doe", "jane@example.com", "", "", ttl)
+			if err := r.CreateInvite(invite); err != nil {
+				t.Fatalf("CreateInvite() error = %v", err)
+			}
+			if tt.prepare != nil {
+				tt.prepare(t, r, invite)
+			}
+			token := invite.Token
+			if tt.token != nil {
+				token = tt.token(invite)
+			}
+
+			var accepted *Testimonial
+			for i, wantErr := range tt.wantErrs {
+				testimonial := NewTestimonial("Jane Doe", "Manager", "Acme", "Great work on the project.", "")
+				err := r.SubmitTestimonial(token, testimonial)
+				if !errors.Is(err, wantErr) {
+					t.Fatalf("submission %d: error = %v, want %v", i+1, err, wantErr)
+				}
+				if err == nil {
+					accepted = testimonial
+				}
+			}
+
+			pending, err := r.GetTestimonials(TestimonialPending)
+			if err != nil {
+				t.Fatalf("GetTestimonials() error = %v", err)
+			}
+			if accepted == nil {
+				if len(pending) != 0 {
+					t.Errorf("pending testimonials = %d, want 0", len(pending))
+				}
+				return
+			}
+
+			if len(pending) != 1 || pending[0].ID != accepted.ID {
+				t.Fatalf("pending testimonials = %v, want [%s]", pending, accepted.ID)
+			}
+			if pending[0].Status != TestimonialPending || pending[0].InviteID != invite.ID {
+				t.Errorf("testimonial status = %q, invite = %q", pending[0].Status, pending[0].InviteID)
+			}
+			if approved, _ := r.GetApprovedTestimonials(false, ""); len(approved) != 0 {
+				t.Errorf("approved testimonials = %d, want 0", len(approved))
+			}
+
+			used, err := r.GetInviteByID(invite.ID)
+			if err != nil {
+				t.Fatalf("GetInviteByID() error = %v", err)
+			}
+			if !used.Used() || used.TestimonialID != accepted.ID {
+				t.Errorf("invite used_at = %v, testimonial = %q", used.UsedAt, used.TestimonialID)
+			}
+			if _, err := r.GetInviteByToken(invite.Token); !errors.Is(err, ErrInviteUsed) {
+				t.Errorf("GetInviteByToken() error = %v, want %v", err, ErrInviteUsed)
+			}
+		})
+	}
+}
+
+func TestSubmitTestimonialConcurrent(t *testing.T) {
+	_, client := newTestRedis(t)
+	r := NewTestimonialsRepository(client)
+
+	invite := NewTestimonialInvite("Jane Doe", "", "", "", time.Hour)
+	if err := r.CreateInvite(invite); err != nil {
+		t.Fatalf("CreateInvite() error = %v", err)
+	}
+
+	const submissions = 8
+	errs := make(chan error, submissions)
+	var wg sync.WaitGroup
+	for i := 0; i < submissions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			testimonial := NewTestimonial("Jane Doe", "", "", "Great work on the project.", "")
+			errs <- r.SubmitTestimonial(invite.Token, testimonial)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	accepted := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			accepted++
+		case !errors.Is(err, ErrInviteUsed):
+			t.Errorf("SubmitTestimonial() error = %v, want nil or %v", err, ErrInviteUsed)
+		}
+	}
+	if accepted != 1 {
+		t.Errorf("accepted submissions = %d, want 1", accepted)
+	}
+
+	testimonials, err := r.GetTestimonials("")
+	if err != nil {
+		t.Fatalf("GetTestimonials() error = %v", err)
+	}
+	if len(testimonials) != 1 {
+		t.Errorf("stored testimonials = %d, want 1", len(testimonials))
+	}
+}
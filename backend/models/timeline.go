@@ -0,0 +1,127 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Timeline entry türleri (about sayfasındaki bölümler)
+const (
+	TimelineTypeWork          = "work"          // İş deneyimi
+	TimelineTypeEducation     = "education"     // Okul, bootcamp
+	TimelineTypeCertification = "certification" // Sertifika (Start: alınma, End: geçerlilik sonu)
+)
+
+// TimelineTypes - Desteklenen türler (gruplu listede bu sırayla)
+var TimelineTypes = []string{TimelineTypeWork, TimelineTypeEducation, TimelineTypeCertification}
+
+// IsValidTimelineType - Desteklenen tür mü?
+func IsValidTimelineType(entryType string) bool {
+	for _, t := range TimelineTypes {
+		if t == entryType {
+			return true
+		}
+	}
+	return false
+}
+
+// TimelineSkill - Entry'de kullanılan skill (okuma sırasında skill'den doldurulur)
+type TimelineSkill struct {
+	ID    string `json:"id"`
+	Skill string `json:"skill"`
+	Icon  string `json:"icon"`
+}
+
+// TimelineEntry - İş deneyimi, eğitim veya sertifika
+// Tarihler "2021-03" veya "2021-03-15", End boşsa devam ediyor
+type TimelineEntry struct {
+	ID           string          `json:"id" yaml:"id"` // "timeline:3f9a1c2b7d4e"
+	Type         string          `json:"type" yaml:"type"`
+	Organization string          `json:"organization" yaml:"organization"`             // Şirket, okul veya sertifikayı veren kurum
+	Role         string          `json:"role" yaml:"role"`                             // Pozisyon, bölüm/derece veya sertifika adı
+	URL          string          `json:"url,omitempty" yaml:"url,omitempty"`           // Kurum sitesi veya sertifika doğrulama linki
+	Location     string          `json:"location,omitempty" yaml:"location,omitempty"` // "Istanbul, TR" veya "Remote"
+	Start        string          `json:"start" yaml:"start"`
+	End          string          `json:"end,omitempty" yaml:"end,omitempty"`
+	Description  string          `json:"description,omitempty" yaml:"description,omitempty"` // Markdown
+	SkillIDs     []string        `json:"skill_ids" yaml:"skill_ids"`                         // "skill:Backend:Go"
+	Skills       []TimelineSkill `json:"skills,omitempty" yaml:"-"`                          // SkillIDs'den, kaydedilmez
+	CreatedAt    time.Time       `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt    time.Time       `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+// TimelineResponse - Liste response'u
+type TimelineResponse struct {
+	Count   int             `json:"count"`
+	Entries []TimelineEntry `json:"entries"`
+}
+
+// NewTimelineEntry - Yeni entry oluşturucu
+func NewTimelineEntry(entryType, organization, role, start, end string) *TimelineEntry {
+	return &TimelineEntry{
+		ID:           "timeline:" + randomHex(6),
+		Type:         entryType,
+		Organization: organization,
+		Role:         role,
+		Start:        start,
+		End:          end,
+		SkillIDs:     []string{},
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// ToJSON - JSON string'e çevir (Skills kaydedilmez, okumada yeniden doldurulur)
+func (e *TimelineEntry) ToJSON() (string, error) {
+	stored := *e
+	stored.Skills = nil
+	jsonBytes, err := json.Marshal(stored)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// FromJSON - JSON string'den struct oluştur
+func (e *TimelineEntry) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), e)
+}
+
+// Validate - Tür, zorunlu alanlar, link ve tarih formatı
+func (e *TimelineEntry) Validate() error {
+	if !IsValidTimelineType(e.Type) {
+		return fmt.Errorf("invalid type: %q (%s)", e.Type, strings.Join(TimelineTypes, ", "))
+	}
+	if strings.TrimSpace(e.Organization) == "" {
+		return fmt.Errorf("organization is required")
+	}
+	if strings.TrimSpace(e.Role) == "" {
+		return fmt.Errorf("role is required")
+	}
+	if e.URL != "" && !isAbsoluteURL(e.URL) {
+		return fmt.Errorf("invalid url: %s", e.URL)
+	}
+	return validateDateRange(e.Start, e.End)
+}
+
+// StartTime - Sıralama index'i için başlangıç tarihi (geçersizse sıfır zaman)
+func (e *TimelineEntry) StartTime() time.Time {
+	start, _ := parseTimeframeDate(e.Start)
+	return start
+}
+
+// uniqueSkillIDs - Boş ve tekrar eden ID'leri at (sıra korunur)
+func (e *TimelineEntry) uniqueSkillIDs() []string {
+	skillIDs := make([]string, 0, len(e.SkillIDs))
+	seen := make(map[string]bool, len(e.SkillIDs))
+	for _, skillID := range e.SkillIDs {
+		skillID = strings.TrimSpace(skillID)
+		if skillID != "" && !seen[skillID] {
+			seen[skillID] = true
+			skillIDs = append(skillIDs, skillID)
+		}
+	}
+	return skillIDs
+}
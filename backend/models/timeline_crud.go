@@ -0,0 +1,311 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrUnknownSkill - Entry'de var olmayan bir skill ID'si
+var ErrUnknownSkill = errors.New("unknown skill")
+
+// Timeline index key'leri ("timeline:" entry ID'leri ile karışmasın diye "timelines:")
+// "timelines:by_date": tüm entry'ler, score = başlangıç tarihi
+// "timelines:type:{type}": türe göre, score = başlangıç tarihi
+// "skills:timeline:{skillID}": skill -> entry reverse index'i (skill taşınınca ID'ler güncellenir)
+const (
+	timelineByDateKey      = "timelines:by_date"
+	skillTimelineKeyPrefix = "skills:timeline:"
+)
+
+func timelineTypeKey(entryType string) string {
+	return "timelines:type:" + entryType
+}
+
+func skillTimelineKey(skillID string) string {
+	return skillTimelineKeyPrefix + skillID
+}
+
+// TimelineGroup - Bir türdeki entry'ler (about sayfası bölümleri)
+type TimelineGroup struct {
+	Type    string          `json:"type"`
+	Count   int             `json:"count"`
+	Entries []TimelineEntry `json:"entries"`
+}
+
+// TimelineRepository - Timeline entry'leri için CRUD operations
+type TimelineRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewTimelineRepository - Yeni repository oluştur
+func NewTimelineRepository(client *redis.Client) *TimelineRepository {
+	return &TimelineRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// CreateEntry - Yeni entry ekle
+// Skill ID'leri var olan skill'ler olmalı (yoksa ErrUnknownSkill)
+func (r *TimelineRepository) CreateEntry(entry *TimelineEntry) error {
+	return r.saveEntry(entry, nil)
+}
+
+// UpdateEntry - Entry'yi güncelle (tür veya tarih değiştiyse index'ler de taşınır)
+func (r *TimelineRepository) UpdateEntry(entry *TimelineEntry) error {
+	previous, err := r.GetEntryByID(entry.ID)
+	if err != nil {
+		return err
+	}
+	entry.UpdatedAt = time.Now()
+	return r.saveEntry(entry, previous)
+}
+
+// saveEntry - Entry'yi ve index'lerini yaz, previous varsa eski index'leri temizle
+func (r *TimelineRepository) saveEntry(entry *TimelineEntry, previous *TimelineEntry) error {
+	entry.SkillIDs = entry.uniqueSkillIDs()
+	// Zaten bağlı olan (sonradan silinmiş olabilir) skill'ler tekrar kontrol edilmez
+	var known []string
+	if previous != nil {
+		known = previous.SkillIDs
+	}
+	if err := r.checkSkills(entry.SkillIDs, known); err != nil {
+		return err
+	}
+
+	entryJSON, err := entry.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal timeline entry: %w", err)
+	}
+
+	score := float64(entry.StartTime().Unix())
+	pipe := r.client.TxPipeline()
+	pipe.Set(r.ctx, entry.ID, entryJSON, time.Hour*24*365)
+
+	if previous != nil {
+		if previous.Type != entry.Type {
+			pipe.ZRem(r.ctx, timelineTypeKey(previous.Type), entry.ID)
+		}
+		for _, skillID := range previous.SkillIDs {
+			pipe.SRem(r.ctx, skillTimelineKey(skillID), entry.ID)
+		}
+	}
+
+	pipe.ZAdd(r.ctx, timelineByDateKey, redis.Z{Score: score, Member: entry.ID})
+	pipe.ZAdd(r.ctx, timelineTypeKey(entry.Type), redis.Z{Score: score, Member: entry.ID})
+	for _, skillID := range entry.SkillIDs {
+		pipe.SAdd(r.ctx, skillTimelineKey(skillID), entry.ID)
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to save timeline entry to Redis: %w", err)
+	}
+
+	resolved := []TimelineEntry{*entry}
+	if err := r.resolveSkills(resolved); err != nil {
+		return err
+	}
+	entry.Skills = resolved[0].Skills
+	return nil
+}
+
+// GetEntryByID - ID'ye göre entry
+func (r *TimelineRepository) GetEntryByID(entryID string) (*TimelineEntry, error) {
+	if !strings.HasPrefix(entryID, "timeline:") {
+		return nil, fmt.Errorf("timeline entry not found: %s", entryID)
+	}
+
+	entryJSON, err := r.client.Get(r.ctx, entryID).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("timeline entry not found: %s", entryID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline entry: %w", err)
+	}
+
+	var entry TimelineEntry
+	if err := entry.FromJSON(entryJSON); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal timeline entry: %w", err)
+	}
+	entries := []TimelineEntry{entry}
+	if err := r.resolveSkills(entries); err != nil {
+		return nil, err
+	}
+	return &entries[0], nil
+}
+
+// GetEntries - Entry'ler, en yeni başlayan önce (entryType boşsa tüm türler)
+func (r *TimelineRepository) GetEntries(entryType string) ([]TimelineEntry, error) {
+	key := timelineByDateKey
+	if entryType != "" {
+		key = timelineTypeKey(entryType)
+	}
+
+	entryIDs, err := r.client.ZRevRange(r.ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline entry IDs: %w", err)
+	}
+	return r.getEntriesByIDs(entryIDs)
+}
+
+// GetGroupedEntries - Her tür ve entry'leri (boş türler dahil, TimelineTypes sırasıyla)
+func (r *TimelineRepository) GetGroupedEntries() ([]TimelineGroup, error) {
+	entries, err := r.GetEntries("")
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]TimelineGroup, 0, len(TimelineTypes))
+	for _, entryType := range TimelineTypes {
+		group := TimelineGroup{Type: entryType, Entries: []TimelineEntry{}}
+		for _, entry := range entries {
+			if entry.Type == entryType {
+				group.Entries = append(group.Entries, entry)
+			}
+		}
+		group.Count = len(group.Entries)
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// GetTimelineResponse - Liste response'u
+func (r *TimelineRepository) GetTimelineResponse(entryType string) (*TimelineResponse, error) {
+	entries, err := r.GetEntries(entryType)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelineResponse{
+		Count:   len(entries),
+		Entries: entries,
+	}, nil
+}
+
+// DeleteEntry - Entry'yi ve index'lerini sil
+func (r *TimelineRepository) DeleteEntry(entryID string) error {
+	entry, err := r.GetEntryByID(entryID)
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(r.ctx, entry.ID)
+	pipe.ZRem(r.ctx, timelineByDateKey, entry.ID)
+	pipe.ZRem(r.ctx, timelineTypeKey(entry.Type), entry.ID)
+	for _, skillID := range entry.SkillIDs {
+		pipe.SRem(r.ctx, skillTimelineKey(skillID), entry.ID)
+	}
+
+	if _, err := pipe.Exec(r.ctx); err != nil {
+		return fmt.Errorf("failed to delete timeline entry: %w", err)
+	}
+	return nil
+}
+
+// Helper Methods
+
+// getEntriesByIDs - Entry'leri ID sırasıyla oku (silinmiş olanlar atlanır)
+func (r *TimelineRepository) getEntriesByIDs(entryIDs []string) ([]TimelineEntry, error) {
+	entries := make([]TimelineEntry, 0, len(entryIDs))
+	if len(entryIDs) == 0 {
+		return entries, nil
+	}
+
+	entryJSONs, err := r.client.MGet(r.ctx, entryIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline entries from Redis: %w", err)
+	}
+
+	for i, entryJSON := range entryJSONs {
+		if entryJSON == nil {
+			continue
+		}
+		var entry TimelineEntry
+		if err := entry.FromJSON(entryJSON.(string)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal timeline entry %s: %w", entryIDs[i], err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := r.resolveSkills(entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// checkSkills - known'da olmayan tüm skill ID'leri var mı?
+func (r *TimelineRepository) checkSkills(skillIDs, known []string) error {
+	skip := make(map[string]bool, len(known))
+	for _, skillID := range known {
+		skip[skillID] = true
+	}
+	for _, skillID := range skillIDs {
+		if skip[skillID] {
+			continue
+		}
+		if !strings.HasPrefix(skillID, SkillKeyPrefix) {
+			return fmt.Errorf("%w: %s", ErrUnknownSkill, skillID)
+		}
+		exists, err := r.client.Exists(r.ctx, skillID).Result()
+		if err != nil {
+			return fmt.Errorf("failed to check skill: %w", err)
+		}
+		if exists == 0 {
+			return fmt.Errorf("%w: %s", ErrUnknownSkill, skillID)
+		}
+	}
+	return nil
+}
+
+// resolveSkills - Skills alanını güncel skill isim/icon'larıyla doldur
+// Silinmiş skill'ler listede görünmez (ID SkillIDs'de kalır)
+func (r *TimelineRepository) resolveSkills(entries []TimelineEntry) error {
+	var skillIDs []string
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		for _, skillID := range entry.SkillIDs {
+			if !seen[skillID] {
+				seen[skillID] = true
+				skillIDs = append(skillIDs, skillID)
+			}
+		}
+	}
+
+	skills := make(map[string]Skill, len(skillIDs))
+	if len(skillIDs) > 0 {
+		skillJSONs, err := r.client.MGet(r.ctx, skillIDs...).Result()
+		if err != nil {
+			return fmt.Errorf("failed to get timeline skills from Redis: %w", err)
+		}
+		for i, skillJSON := range skillJSONs {
+			if skillJSON == nil {
+				continue
+			}
+			var skill Skill
+			if err := skill.FromJSON(skillJSON.(string)); err != nil {
+				return fmt.Errorf("failed to unmarshal skill %s: %w", skillIDs[i], err)
+			}
+			skills[skillIDs[i]] = skill
+		}
+	}
+
+	for i := range entries {
+		entries[i].Skills = []TimelineSkill{}
+		for _, skillID := range entries[i].SkillIDs {
+			if skill, ok := skills[skillID]; ok {
+				entries[i].Skills = append(entries[i].Skills, TimelineSkill{
+					ID:    skillID,
+					Skill: skill.Skill,
+					Icon:  skill.Icon,
+				})
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Webhook delivery status'ları
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// Webhook - Admin tarafından tanımlanan outbound webhook
+type Webhook struct {
+	ID          string    `json:"id"`                    // "webhook:3f9a..."
+	URL         string    `json:"url"`                   // POST edilecek adres
+	Secret      string    `json:"secret,omitempty"`      // HMAC-SHA256 imza anahtarı
+	Events      []string  `json:"events"`                // ["post.published", "project.deleted"] veya ["*"]
+	Active      bool      `json:"active"`                // Pasif webhook'lara gönderim yapılmaz
+	Description string    `json:"description,omitempty"` // Admin notu
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WebhookDelivery - Tek bir gönderim denemesi zinciri (retry'lar dahil)
+type WebhookDelivery struct {
+	ID             string     `json:"id"`                         // "webhook_delivery:8c1e..."
+	WebhookID      string     `json:"webhook_id"`                 // Hangi webhook'a
+	EventID        string     `json:"event_id"`                   // Alıcı tarafında dedupe için
+	Event          string     `json:"event"`                      // "post.published"
+	Payload        string     `json:"payload"`                    // Gönderilen JSON body
+	Status         string     `json:"status"`                     // pending, succeeded, failed
+	Attempts       int        `json:"attempts"`                   // Kaç kez denendi
+	LastStatusCode int        `json:"last_status_code,omitempty"` // Son HTTP cevabı
+	LastError      string     `json:"last_error,omitempty"`       // Son hata mesajı
+	RedeliveryOf   string     `json:"redelivery_of,omitempty"`    // Redeliver ile oluşturulduysa orijinal ID
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+}
+
+// NewWebhook - Yeni webhook oluşturucu (secret verilmezse üretilir)
+func NewWebhook(url, secret, description string, events []string) *Webhook {
+	if secret == "" {
+		secret = randomHex(32)
+	}
+
+	return &Webhook{
+		ID:          "webhook:" + randomHex(8),
+		URL:         url,
+		Secret:      secret,
+		Events:      events,
+		Active:      true,
+		Description: description,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// NewWebhookDelivery - Yeni pending delivery
+func NewWebhookDelivery(webhookID, eventID, event, payload string) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:        "webhook_delivery:" + randomHex(8),
+		WebhookID: webhookID,
+		EventID:   eventID,
+		Event:     event,
+		Payload:   payload,
+		Status:    DeliveryPending,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewEventID - Event başına unique ID (redeliver'da aynı kalır)
+func NewEventID() string {
+	return "evt_" + randomHex(12)
+}
+
+// Subscribes - Webhook bu event'i dinliyor mu?
+func (w *Webhook) Subscribes(event string) bool {
+	for _, e := range w.Events {
+		if e == "*" || e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Masked - Listelemede secret gösterilmez
+func (w Webhook) Masked() Webhook {
+	if len(w.Secret) > 4 {
+		w.Secret = "••••" + w.Secret[len(w.Secret)-4:]
+	}
+	return w
+}
+
+// ToJSON ve FromJSON methodları
+func (w *Webhook) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(w)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (w *Webhook) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), w)
+}
+
+func (d *WebhookDelivery) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+func (d *WebhookDelivery) FromJSON(jsonStr string) error {
+	return json.Unmarshal([]byte(jsonStr), d)
+}
+
+// randomHex - n byte'lık random hex string
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,235 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Webhook başına saklanacak delivery sayısı ve süresi
+const (
+	maxDeliveriesPerWebhook = 100
+	deliveryRetention       = time.Hour * 24 * 30
+)
+
+// WebhooksRepository - Webhook ve delivery log'u için CRUD operations
+type WebhooksRepository struct {
+	client *redis.Client
+	ctx    context.Context
+}
+
+// NewWebhooksRepository - Repository oluştur
+func NewWebhooksRepository(client *redis.Client) *WebhooksRepository {
+	return &WebhooksRepository{
+		client: client,
+		ctx:    context.Background(),
+	}
+}
+
+// Webhook Operations
+
+// SaveWebhook - Webhook kaydet (create veya update)
+func (r *WebhooksRepository) SaveWebhook(webhook *Webhook) error {
+	webhookJSON, err := webhook.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook: %w", err)
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.Set(r.ctx, webhook.ID, webhookJSON, 0)
+	pipe.SAdd(r.ctx, "webhooks:all", webhook.ID)
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhookByID - ID'ye göre webhook getir
+func (r *WebhooksRepository) GetWebhookByID(webhookID string) (*Webhook, error) {
+	webhookJSON, err := r.client.Get(r.ctx, webhookID).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("webhook not found: %s", webhookID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	var webhook Webhook
+	err = webhook.FromJSON(webhookJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// GetAllWebhooks - Tüm webhook'lar (oluşturulma sırasına göre)
+func (r *WebhooksRepository) GetAllWebhooks() ([]Webhook, error) {
+	webhookIDs, err := r.client.SMembers(r.ctx, "webhooks:all").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook IDs: %w", err)
+	}
+
+	if len(webhookIDs) == 0 {
+		return []Webhook{}, nil
+	}
+
+	webhookJSONs, err := r.client.MGet(r.ctx, webhookIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	webhooks := make([]Webhook, 0, len(webhookJSONs))
+	for i, webhookJSON := range webhookJSONs {
+		if webhookJSON == nil {
+			continue
+		}
+
+		var webhook Webhook
+		err = webhook.FromJSON(webhookJSON.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal webhook %s: %w", webhookIDs[i], err)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+	})
+
+	return webhooks, nil
+}
+
+// GetWebhooksForEvent - Event'i dinleyen aktif webhook'lar
+func (r *WebhooksRepository) GetWebhooksForEvent(event string) ([]Webhook, error) {
+	webhooks, err := r.GetAllWebhooks()
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []Webhook
+	for _, webhook := range webhooks {
+		if webhook.Active && webhook.Subscribes(event) {
+			matching = append(matching, webhook)
+		}
+	}
+
+	return matching, nil
+}
+
+// DeleteWebhook - Webhook'u ve delivery log'unu sil
+func (r *WebhooksRepository) DeleteWebhook(webhookID string) error {
+	if _, err := r.GetWebhookByID(webhookID); err != nil {
+		return err
+	}
+
+	deliveryIDs, err := r.client.ZRange(r.ctx, deliveriesKey(webhookID), 0, -1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.Del(r.ctx, webhookID)
+	pipe.SRem(r.ctx, "webhooks:all", webhookID)
+	pipe.Del(r.ctx, deliveriesKey(webhookID))
+	for _, deliveryID := range deliveryIDs {
+		pipe.Del(r.ctx, deliveryID)
+	}
+
+	_, err = pipe.Exec(r.ctx)
+	return err
+}
+
+// Delivery Operations
+
+// SaveDelivery - Delivery kaydet ve webhook'un log'una ekle
+// Log webhook başına son 100 delivery ile sınırlı
+func (r *WebhooksRepository) SaveDelivery(delivery *WebhookDelivery) error {
+	deliveryJSON, err := delivery.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal delivery: %w", err)
+	}
+
+	key := deliveriesKey(delivery.WebhookID)
+
+	pipe := r.client.Pipeline()
+	pipe.Set(r.ctx, delivery.ID, deliveryJSON, deliveryRetention)
+	pipe.ZAdd(r.ctx, key, redis.Z{
+		Score:  float64(delivery.CreatedAt.UnixNano()),
+		Member: delivery.ID,
+	})
+	pipe.ZRemRangeByRank(r.ctx, key, 0, -maxDeliveriesPerWebhook-1)
+
+	_, err = pipe.Exec(r.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeliveryByID - ID'ye göre delivery getir
+func (r *WebhooksRepository) GetDeliveryByID(deliveryID string) (*WebhookDelivery, error) {
+	deliveryJSON, err := r.client.Get(r.ctx, deliveryID).Result()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("delivery not found: %s", deliveryID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	var delivery WebhookDelivery
+	err = delivery.FromJSON(deliveryJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delivery: %w", err)
+	}
+
+	return &delivery, nil
+}
+
+// GetDeliveries - Webhook'un delivery log'u (en yeni first)
+func (r *WebhooksRepository) GetDeliveries(webhookID string, count int) ([]WebhookDelivery, error) {
+	deliveryIDs, err := r.client.ZRevRange(r.ctx, deliveriesKey(webhookID), 0, int64(count-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery IDs: %w", err)
+	}
+
+	if len(deliveryIDs) == 0 {
+		return []WebhookDelivery{}, nil
+	}
+
+	deliveryJSONs, err := r.client.MGet(r.ctx, deliveryIDs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliveries: %w", err)
+	}
+
+	deliveries := make([]WebhookDelivery, 0, len(deliveryJSONs))
+	for i, deliveryJSON := range deliveryJSONs {
+		if deliveryJSON == nil {
+			continue // Retention süresi dolmuş
+		}
+
+		var delivery WebhookDelivery
+		err = delivery.FromJSON(deliveryJSON.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal delivery %s: %w", deliveryIDs[i], err)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// Helper functions
+
+func deliveriesKey(webhookID string) string {
+	return webhookID + ":deliveries"
+}
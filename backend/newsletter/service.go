@@ -0,0 +1,205 @@
+package newsletter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/mailer"
+	"portfolio-backend/models"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// Service - Newsletter iş mantığı
+// Handler'lar (newsletter + blog publish) bu servisi kullanır
+type Service struct {
+	config          *config.Config
+	subscribersRepo *models.SubscribersRepository
+	mailer          mailer.Mailer
+}
+
+// NewService - Yeni newsletter servisi oluştur
+func NewService(cfg *config.Config, redisClient *redis.Client) *Service {
+	return &Service{
+		config:          cfg,
+		subscribersRepo: models.NewSubscribersRepository(redisClient),
+		mailer:          mailer.New(cfg),
+	}
+}
+
+// Repository - Admin handler'ı için repository erişimi
+func (s *Service) Repository() *models.SubscribersRepository {
+	return s.subscribersRepo
+}
+
+// Subscribe - Abonelik isteği (double opt-in)
+// Zaten confirmed ise tekrar mail atılmaz; pending/unsubscribed ise yeni confirm maili gider
+func (s *Service) Subscribe(email string) error {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || address.Address != strings.TrimSpace(email) {
+		return ErrInvalidEmail
+	}
+
+	subscriber, err := s.subscribersRepo.GetSubscriberByEmail(address.Address)
+	if err != nil {
+		subscriber = models.NewSubscriber(address.Address)
+	}
+
+	if subscriber.Status == models.SubscriberConfirmed {
+		return nil
+	}
+
+	subscriber.Status = models.SubscriberPending
+	if err := s.subscribersRepo.SaveSubscriber(subscriber); err != nil {
+		return err
+	}
+
+	return s.sendConfirmation(subscriber)
+}
+
+// Confirm - Confirm token'ı ile aboneliği onayla
+func (s *Service) Confirm(token string) (*models.Subscriber, error) {
+	email, err := VerifyToken(s.config.Newsletter.TokenSecret, PurposeConfirm, token)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber, err := s.subscribersRepo.GetSubscriberByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscriber.Status != models.SubscriberConfirmed {
+		subscriber.Confirm()
+		if err := s.subscribersRepo.SaveSubscriber(subscriber); err != nil {
+			return nil, err
+		}
+	}
+
+	return subscriber, nil
+}
+
+// Unsubscribe - Unsubscribe token'ı ile abonelikten çık
+func (s *Service) Unsubscribe(token string) (*models.Subscriber, error) {
+	email, err := VerifyToken(s.config.Newsletter.TokenSecret, PurposeUnsubscribe, token)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber, err := s.subscribersRepo.GetSubscriberByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscriber.Status != models.SubscriberUnsubscribed {
+		subscriber.Unsubscribe()
+		if err := s.subscribersRepo.SaveSubscriber(subscriber); err != nil {
+			return nil, err
+		}
+	}
+
+	return subscriber, nil
+}
+
+// NotifyNewPost - Yayına alınan post için confirmed abonelere mail at
+// Her post için bir kez gönderilir (unpublish/publish tekrarında yeniden gitmez)
+// Uzun sürebileceği için handler'dan goroutine ile çağrılır
+func (s *Service) NotifyNewPost(post *models.BlogPost) {
+	first, err := s.subscribersRepo.MarkPostNotified(post.ID)
+	if err != nil {
+		log.Printf("Newsletter: failed to mark %s notified: %v", post.ID, err)
+		return
+	}
+	if !first {
+		return
+	}
+
+	subscribers, err := s.subscribersRepo.GetSubscribers(models.SubscriberConfirmed)
+	if err != nil {
+		log.Printf("Newsletter: failed to get subscribers for %s: %v", post.ID, err)
+		return
+	}
+
+	postURL := strings.TrimRight(s.config.Newsletter.SiteURL, "/") + "/blog/" + url.PathEscape(post.Slug)
+
+	sent := 0
+	for _, subscriber := range subscribers {
+		unsubscribeURL := s.unsubscribeURL(subscriber.Email)
+		data := newPostData{
+			Title:          post.Title,
+			Excerpt:        post.Excerpt,
+			ReadingTime:    post.ReadingTime,
+			PostURL:        postURL,
+			UnsubscribeURL: unsubscribeURL,
+		}
+
+		text, err := renderText(newPostText, data)
+		if err != nil {
+			log.Printf("Newsletter: failed to render text template: %v", err)
+			return
+		}
+		html, err := renderHTML(newPostHTML, data)
+		if err != nil {
+			log.Printf("Newsletter: failed to render html template: %v", err)
+			return
+		}
+
+		err = s.mailer.Send(mailer.Message{
+			To:      subscriber.Email,
+			Subject: "New post: " + post.Title,
+			Text:    text,
+			HTML:    html,
+			Headers: map[string]string{
+				"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+				"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+			},
+		})
+		if err != nil {
+			log.Printf("Newsletter: failed to send %s to %s: %v", post.ID, subscriber.Email, err)
+			continue
+		}
+		sent++
+	}
+
+	log.Printf("Newsletter: %s sent to %d/%d subscribers", post.ID, sent, len(subscribers))
+}
+
+// Helper functions
+
+func (s *Service) sendConfirmation(subscriber *models.Subscriber) error {
+	ttl, err := time.ParseDuration(s.config.Newsletter.ConfirmTTL)
+	if err != nil {
+		ttl = 48 * time.Hour
+	}
+
+	token := SignToken(s.config.Newsletter.TokenSecret, PurposeConfirm, subscriber.Email, ttl)
+	text, err := renderText(confirmText, confirmData{
+		ConfirmURL: s.apiURL("/newsletter/confirm", token),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render confirmation: %w", err)
+	}
+
+	return s.mailer.Send(mailer.Message{
+		To:      subscriber.Email,
+		Subject: "Please confirm your subscription",
+		Text:    text,
+	})
+}
+
+func (s *Service) unsubscribeURL(email string) string {
+	token := SignToken(s.config.Newsletter.TokenSecret, PurposeUnsubscribe, email, 0)
+	return s.apiURL("/newsletter/unsubscribe", token)
+}
+
+func (s *Service) apiURL(path, token string) string {
+	return strings.TrimRight(s.config.Newsletter.PublicURL, "/") + "/api/v1" + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,79 @@
+package newsletter
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Mail şablonları
+// Text ve HTML versiyonları aynı data ile render edilir
+
+var confirmText = texttemplate.Must(texttemplate.New("confirm").Parse(`Hi,
+
+Please confirm your subscription to new posts by opening the link below:
+
+{{.ConfirmURL}}
+
+If you didn't ask for this, you can ignore this email.
+`))
+
+var newPostText = texttemplate.Must(texttemplate.New("new_post").Parse(`New post: {{.Title}}
+
+{{.Excerpt}}
+
+Read it here: {{.PostURL}}
+{{if .ReadingTime}}({{.ReadingTime}}){{end}}
+
+--
+You are receiving this because you subscribed to new posts.
+Unsubscribe: {{.UnsubscribeURL}}
+`))
+
+var newPostHTML = htmltemplate.Must(htmltemplate.New("new_post").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; line-height: 1.5; color: #222;">
+  <h2 style="margin-bottom: 4px;">{{.Title}}</h2>
+  {{if .ReadingTime}}<p style="color: #888; margin-top: 0;">{{.ReadingTime}}</p>{{end}}
+  <p>{{.Excerpt}}</p>
+  <p><a href="{{.PostURL}}">Read the full post &rarr;</a></p>
+  <hr style="border: none; border-top: 1px solid #eee;">
+  <p style="font-size: 12px; color: #888;">
+    You are receiving this because you subscribed to new posts.
+    <a href="{{.UnsubscribeURL}}">Unsubscribe</a>
+  </p>
+</body>
+</html>
+`))
+
+// confirmData - Onay maili için template data
+type confirmData struct {
+	ConfirmURL string
+}
+
+// newPostData - Yeni post bildirimi için template data
+type newPostData struct {
+	Title          string
+	Excerpt        string
+	ReadingTime    string
+	PostURL        string
+	UnsubscribeURL string
+}
+
+// renderText - text/template'i string'e render et
+func renderText(tmpl *texttemplate.Template, data interface{}) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// renderHTML - html/template'i string'e render et (otomatik escape)
+func renderHTML(tmpl *htmltemplate.Template, data interface{}) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,80 @@
+package newsletter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Token amaçları - confirm token'ı unsubscribe için kullanılamaz
+const (
+	PurposeConfirm     = "confirm"
+	PurposeUnsubscribe = "unsubscribe"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token expired")
+)
+
+// SignToken - HMAC-SHA256 imzalı token oluştur
+// Format: base64url("purpose|email|expiresUnix") + "." + base64url(hmac)
+// ttl 0 ise token süresiz (unsubscribe linkleri)
+func SignToken(secret, purpose, email string, ttl time.Duration) string {
+	var expires int64
+	if ttl > 0 {
+		expires = time.Now().Add(ttl).Unix()
+	}
+
+	payload := fmt.Sprintf("%s|%s|%d", purpose, email, expires)
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+
+	return encoded + "." + sign(secret, encoded)
+}
+
+// VerifyToken - Token'ı doğrula ve e-postayı döndür
+func VerifyToken(secret, purpose, token string) (string, error) {
+	encoded, signature, found := strings.Cut(token, ".")
+	if !found {
+		return "", ErrInvalidToken
+	}
+
+	// Constant-time karşılaştırma
+	if !hmac.Equal([]byte(signature), []byte(sign(secret, encoded))) {
+		return "", ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+
+	// E-postanın local part'ı "|" içerebilir: purpose baştan, expires sondan ayrılır
+	tokenPurpose, rest, found := strings.Cut(string(payload), "|")
+	separator := strings.LastIndex(rest, "|")
+	if !found || separator < 0 || tokenPurpose != purpose {
+		return "", ErrInvalidToken
+	}
+	email := rest[:separator]
+
+	expires, err := strconv.ParseInt(rest[separator+1:], 10, 64)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if expires > 0 && time.Now().Unix() > expires {
+		return "", ErrExpiredToken
+	}
+
+	return email, nil
+}
+
+func sign(secret, data string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,80 @@
+package newsletter
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "newsletter-secret"
+
+func TestTokenRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		purpose string
+		email   string
+		ttl     time.Duration
+	}{
+		{"confirm", PurposeConfirm, "jane@example.com", 48 * time.Hour},
+		{"unsubscribe without expiry", PurposeUnsubscribe, "jane@example.com", 0},
+		{"pipe in local part", PurposeConfirm, "a|b@example.com", time.Hour},
+		{"several pipes", PurposeUnsubscribe, "|a||b|@example.com", 0},
+		{"unicode local part", PurposeConfirm, "çağrı@example.com", time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := SignToken(testSecret, tt.purpose, tt.email, tt.ttl)
+			email, err := VerifyToken(testSecret, tt.purpose, token)
+			if err != nil {
+				t.Fatalf("VerifyToken: %v", err)
+			}
+			if email != tt.email {
+				t.Errorf("email = %q, want %q", email, tt.email)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenRejects(t *testing.T) {
+	valid := SignToken(testSecret, PurposeConfirm, "jane@example.com", time.Hour)
+	encoded, signature, _ := strings.Cut(valid, ".")
+
+	// forge - Aynı secret ile imzalanmış ama elle kurulmuş payload
+	forge := func(payload string) string {
+		encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+		return encoded + "." + sign(testSecret, encoded)
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		purpose string
+		token   string
+		want    error
+	}{
+		{"wrong purpose", testSecret, PurposeUnsubscribe, valid, ErrInvalidToken},
+		{"wrong secret", "other-secret", PurposeConfirm, valid, ErrInvalidToken},
+		{"no separator", testSecret, PurposeConfirm, encoded + signature, ErrInvalidToken},
+		{"tampered payload", testSecret, PurposeConfirm, encoded + "x." + signature, ErrInvalidToken},
+		{"tampered signature", testSecret, PurposeConfirm, encoded + "." + signature[1:], ErrInvalidToken},
+		{"empty", testSecret, PurposeConfirm, "", ErrInvalidToken},
+		{"expired", testSecret, PurposeConfirm, forge(fmt.Sprintf("confirm|jane@example.com|%d", time.Now().Add(-time.Minute).Unix())), ErrExpiredToken},
+		{"missing expiry", testSecret, PurposeConfirm, forge("confirm|jane@example.com"), ErrInvalidToken},
+		{"non-numeric expiry", testSecret, PurposeConfirm, forge("confirm|jane@example.com|soon"), ErrInvalidToken},
+		{"purpose only", testSecret, PurposeConfirm, forge("confirm"), ErrInvalidToken},
+		{"invalid base64", testSecret, PurposeConfirm, "!!!." + sign(testSecret, "!!!"), ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email, err := VerifyToken(tt.secret, tt.purpose, tt.token)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("VerifyToken = %q, %v, want %v", email, err, tt.want)
+			}
+		})
+	}
+}
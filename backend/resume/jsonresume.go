@@ -0,0 +1,210 @@
+package resume
+
+import (
+	"time"
+
+	"portfolio-backend/models"
+)
+
+// JSONResume - JSON Resume formatı (https://jsonresume.org/schema, v1.0.0)
+// Kapalı veya boş section'lar çıktıya eklenmez
+// JSON Resume'da section sırası yok, sıra meta.sections'ta tutulur
+type JSONResume struct {
+	Schema       string            `json:"$schema"`
+	Basics       JSONBasics        `json:"basics"`
+	Work         []JSONWork        `json:"work,omitempty"`
+	Education    []JSONEducation   `json:"education,omitempty"`
+	Certificates []JSONCertificate `json:"certificates,omitempty"`
+	Skills       []JSONSkill       `json:"skills,omitempty"`
+	Projects     []JSONProject     `json:"projects,omitempty"`
+	Meta         JSONMeta          `json:"meta"`
+}
+
+// JSONBasics - basics
+type JSONBasics struct {
+	Name     string        `json:"name"`
+	Label    string        `json:"label,omitempty"`
+	Image    string        `json:"image,omitempty"`
+	Email    string        `json:"email,omitempty"`
+	Phone    string        `json:"phone,omitempty"`
+	URL      string        `json:"url,omitempty"`
+	Summary  string        `json:"summary,omitempty"`
+	Location *JSONLocation `json:"location,omitempty"`
+	Profiles []JSONProfile `json:"profiles,omitempty"`
+}
+
+// JSONLocation - basics.location
+type JSONLocation struct {
+	City        string `json:"city,omitempty"`
+	Region      string `json:"region,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+}
+
+// JSONProfile - basics.profiles[]
+type JSONProfile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+// JSONWork - work[]
+type JSONWork struct {
+	Name       string   `json:"name"`
+	Position   string   `json:"position"`
+	URL        string   `json:"url,omitempty"`
+	Location   string   `json:"location,omitempty"`
+	StartDate  string   `json:"startDate"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+// JSONEducation - education[] (timeline role'ü area olarak: "Computer Engineering, BSc")
+type JSONEducation struct {
+	Institution string `json:"institution"`
+	URL         string `json:"url,omitempty"`
+	Area        string `json:"area"`
+	StartDate   string `json:"startDate"`
+	EndDate     string `json:"endDate,omitempty"`
+}
+
+// JSONCertificate - certificates[] (tarih alınma tarihi)
+type JSONCertificate struct {
+	Name   string `json:"name"`
+	Date   string `json:"date"`
+	Issuer string `json:"issuer"`
+	URL    string `json:"url,omitempty"`
+}
+
+// JSONSkill - skills[] (kategori başına bir kayıt, skill isimleri keywords'de)
+type JSONSkill struct {
+	Name     string   `json:"name"`
+	Keywords []string `json:"keywords"`
+}
+
+// JSONProject - projects[]
+type JSONProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+}
+
+// JSONMeta - meta
+type JSONMeta struct {
+	Canonical    string   `json:"canonical,omitempty"`
+	Version      string   `json:"version"`
+	LastModified string   `json:"lastModified"`
+	Sections     []string `json:"sections"` // Section key'leri, ayarlardaki sırayla
+}
+
+// jsonResumeSchema - Editör/validator'lar için $schema
+const jsonResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// ToJSONResume - CV'yi JSON Resume formatına çevir
+// Summary section kapalıysa basics.summary boş kalır
+func (r *Resume) ToJSONResume(canonical string) *JSONResume {
+	profile := r.Profile
+	output := &JSONResume{
+		Schema: jsonResumeSchema,
+		Basics: JSONBasics{
+			Name:  profile.Name,
+			Label: profile.Label,
+			Image: profile.Image,
+			Email: profile.Email,
+			Phone: profile.Phone,
+			URL:   profile.URL,
+		},
+		Meta: JSONMeta{
+			Canonical:    canonical,
+			Version:      "v1.0.0",
+			LastModified: r.GeneratedAt.UTC().Format(time.RFC3339),
+			Sections:     []string{},
+		},
+	}
+
+	if profile.Location != (models.ResumeLocation{}) {
+		output.Basics.Location = &JSONLocation{
+			City:        profile.Location.City,
+			Region:      profile.Location.Region,
+			CountryCode: profile.Location.CountryCode,
+		}
+	}
+	for _, link := range profile.Links {
+		output.Basics.Profiles = append(output.Basics.Profiles, JSONProfile{
+			Network:  link.Network,
+			Username: link.Username,
+			URL:      link.URL,
+		})
+	}
+
+	for _, section := range r.Sections {
+		output.Meta.Sections = append(output.Meta.Sections, section.Key)
+
+		switch section.Key {
+		case models.ResumeSectionSummary:
+			output.Basics.Summary = section.Summary
+		case models.ResumeSectionExperience:
+			for _, experience := range section.Entries {
+				output.Work = append(output.Work, JSONWork{
+					Name:       experience.Organization,
+					Position:   experience.Position,
+					URL:        experience.URL,
+					Location:   experience.Location,
+					StartDate:  experience.Start,
+					EndDate:    experience.End,
+					Summary:    experience.Summary,
+					Highlights: experience.Highlights,
+				})
+			}
+		case models.ResumeSectionEducation:
+			for _, entry := range section.Entries {
+				output.Education = append(output.Education, JSONEducation{
+					Institution: entry.Organization,
+					URL:         entry.URL,
+					Area:        entry.Position,
+					StartDate:   entry.Start,
+					EndDate:     entry.End,
+				})
+			}
+		case models.ResumeSectionCertifications:
+			for _, entry := range section.Entries {
+				output.Certificates = append(output.Certificates, JSONCertificate{
+					Name:   entry.Position,
+					Date:   entry.Start,
+					Issuer: entry.Organization,
+					URL:    entry.URL,
+				})
+			}
+		case models.ResumeSectionSkills:
+			for _, group := range section.Skills {
+				output.Skills = append(output.Skills, JSONSkill{
+					Name:     group.Category,
+					Keywords: skillNames(group),
+				})
+			}
+		case models.ResumeSectionProjects:
+			for _, project := range section.Projects {
+				item := JSONProject{
+					Name:        project.Title,
+					Description: project.Description,
+					URL:         ProjectURL(project),
+					Keywords:    toolNames(project),
+				}
+				if project.Role != "" {
+					item.Roles = []string{project.Role}
+				}
+				if project.Timeframe != nil {
+					item.StartDate = project.Timeframe.Start
+					item.EndDate = project.Timeframe.End
+				}
+				output.Projects = append(output.Projects, item)
+			}
+		}
+	}
+
+	return output
+}
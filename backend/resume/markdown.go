@@ -0,0 +1,134 @@
+package resume
+
+import (
+	"fmt"
+	"strings"
+
+	"portfolio-backend/models"
+)
+
+// ToMarkdown - CV'yi Markdown dokümanı olarak render et
+func (r *Resume) ToMarkdown() string {
+	var b strings.Builder
+	profile := r.Profile
+
+	fmt.Fprintf(&b, "# %s\n\n", nameOrDefault(profile.Name))
+	if profile.Label != "" {
+		fmt.Fprintf(&b, "**%s**\n\n", profile.Label)
+	}
+
+	if contact := contactLine(profile, true); contact != "" {
+		b.WriteString(contact + "\n\n")
+	}
+	if len(profile.Links) > 0 {
+		links := make([]string, 0, len(profile.Links))
+		for _, link := range profile.Links {
+			links = append(links, fmt.Sprintf("[%s](%s)", link.Network, link.URL))
+		}
+		b.WriteString(strings.Join(links, " · ") + "\n\n")
+	}
+
+	for _, section := range r.Sections {
+		fmt.Fprintf(&b, "## %s\n\n", section.Title)
+
+		switch section.Key {
+		case models.ResumeSectionSummary:
+			b.WriteString(section.Summary + "\n\n")
+
+		case models.ResumeSectionExperience, models.ResumeSectionEducation, models.ResumeSectionCertifications:
+			for _, entry := range section.Entries {
+				organization := entry.Organization
+				if entry.URL != "" {
+					organization = fmt.Sprintf("[%s](%s)", organization, entry.URL)
+				}
+				fmt.Fprintf(&b, "### %s — %s\n\n", entry.Position, organization)
+				fmt.Fprintf(&b, "*%s*\n\n", joinNonEmpty(" · ", EntryDates(section.Key, entry), entry.Location))
+				if entry.Summary != "" {
+					b.WriteString(entry.Summary + "\n\n")
+				}
+				for _, highlight := range entry.Highlights {
+					fmt.Fprintf(&b, "- %s\n", highlight)
+				}
+				if len(entry.Highlights) > 0 {
+					b.WriteString("\n")
+				}
+			}
+
+		case models.ResumeSectionSkills:
+			for _, group := range section.Skills {
+				fmt.Fprintf(&b, "- **%s:** %s\n", group.Category, strings.Join(skillNames(group), ", "))
+			}
+			b.WriteString("\n")
+
+		case models.ResumeSectionProjects:
+			for _, project := range section.Projects {
+				title := project.Title
+				if url := ProjectURL(project); url != "" {
+					title = fmt.Sprintf("[%s](%s)", title, url)
+				}
+				fmt.Fprintf(&b, "### %s\n\n", title)
+				if meta := projectMeta(project); meta != "" {
+					fmt.Fprintf(&b, "*%s*\n\n", meta)
+				}
+				if description := strings.TrimSpace(project.Description); description != "" {
+					b.WriteString(description + "\n\n")
+				}
+				if tools := toolNames(project); len(tools) > 0 {
+					fmt.Fprintf(&b, "**Tools:** %s\n\n", strings.Join(tools, ", "))
+				}
+			}
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// contactLine - "mail · telefon · site · konum" (markdown ise e-posta ve site link olur)
+func contactLine(profile models.ResumeProfile, markdown bool) string {
+	email, url := profile.Email, profile.URL
+	if markdown {
+		if email != "" {
+			email = fmt.Sprintf("[%s](mailto:%s)", email, email)
+		}
+		if url != "" {
+			url = fmt.Sprintf("[%s](%s)", displayURL(url), url)
+		}
+	} else {
+		url = displayURL(url)
+	}
+	return joinNonEmpty(" · ", email, profile.Phone, url, Location(profile.Location))
+}
+
+// projectMeta - "Rol · Mar 2023 – Jun 2023"
+func projectMeta(project models.Project) string {
+	var timeframe string
+	if project.Timeframe != nil {
+		timeframe = FormatRange(project.Timeframe.Start, project.Timeframe.End)
+	}
+	return joinNonEmpty(" · ", project.Role, timeframe)
+}
+
+// displayURL - "https://example.com/" -> "example.com"
+func displayURL(url string) string {
+	url = strings.TrimPrefix(url, "https://")
+	url = strings.TrimPrefix(url, "http://")
+	return strings.TrimSuffix(url, "/")
+}
+
+// nameOrDefault - Profil adı henüz girilmemişse
+func nameOrDefault(name string) string {
+	if strings.TrimSpace(name) == "" {
+		return "Resume"
+	}
+	return name
+}
+
+func joinNonEmpty(separator string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, separator)
+}
@@ -0,0 +1,102 @@
+package resume
+
+import (
+	"strings"
+
+	"portfolio-backend/models"
+)
+
+// Punto ve renkler (gray: 0 siyah, 1 beyaz)
+const (
+	nameSize    = 22.0
+	labelSize   = 12.0
+	headingSize = 13.0
+	titleSize   = 11.0
+	bodySize    = 10.0
+	metaSize    = 9.0
+	mutedGray   = 0.4
+)
+
+// ToPDF - CV'yi A4 PDF olarak render et
+// missing: Helvetica'da olmadığı için '?' olarak yazılan karakterler (boşsa metin kayıpsız)
+func (r *Resume) ToPDF() (pdf []byte, missing []rune) {
+	profile := r.Profile
+	name := nameOrDefault(profile.Name)
+	w := newPDFWriter(name)
+
+	w.paragraph(fontBold, nameSize, 0, name, 0)
+	if profile.Label != "" {
+		w.paragraph(fontRegular, labelSize, 0, profile.Label, mutedGray)
+	}
+	w.gap(4)
+	if contact := contactLine(profile, false); contact != "" {
+		w.paragraph(fontRegular, metaSize, 0, contact, mutedGray)
+	}
+	if len(profile.Links) > 0 {
+		links := make([]string, 0, len(profile.Links))
+		for _, link := range profile.Links {
+			links = append(links, link.Network+": "+displayURL(link.URL))
+		}
+		w.paragraph(fontRegular, metaSize, 0, strings.Join(links, " · "), mutedGray)
+	}
+
+	for _, section := range r.Sections {
+		// Başlık sayfanın en altında tek başına kalmasın
+		w.gap(14)
+		w.ensureSpace(headingSize*1.35 + 4 + titleSize*1.35*2)
+		w.paragraph(fontBold, headingSize, 0, strings.ToUpper(section.Title), 0)
+		w.rule()
+		w.gap(4)
+
+		switch section.Key {
+		case models.ResumeSectionSummary:
+			w.paragraph(fontRegular, bodySize, 0, section.Summary, 0)
+
+		case models.ResumeSectionExperience, models.ResumeSectionEducation, models.ResumeSectionCertifications:
+			for i, entry := range section.Entries {
+				if i > 0 {
+					w.gap(8)
+				}
+				w.ensureSpace(titleSize*1.35 + metaSize*1.35)
+				w.paragraph(fontBold, titleSize, 0, entry.Position+" — "+entry.Organization, 0)
+				w.paragraph(fontRegular, metaSize, 0, joinNonEmpty(" · ", EntryDates(section.Key, entry), entry.Location), mutedGray)
+				if entry.Summary != "" {
+					w.gap(2)
+					w.paragraph(fontRegular, bodySize, 0, entry.Summary, 0)
+				}
+				for _, highlight := range entry.Highlights {
+					w.bullet(fontRegular, bodySize, 8, highlight)
+				}
+			}
+
+		case models.ResumeSectionSkills:
+			for _, group := range section.Skills {
+				w.ensureSpace(bodySize * 1.35 * 2)
+				w.paragraph(fontBold, bodySize, 0, group.Category, 0)
+				w.paragraph(fontRegular, bodySize, 8, strings.Join(skillNames(group), ", "), 0)
+				w.gap(3)
+			}
+
+		case models.ResumeSectionProjects:
+			for i, project := range section.Projects {
+				if i > 0 {
+					w.gap(8)
+				}
+				w.ensureSpace(titleSize*1.35 + metaSize*1.35)
+				w.paragraph(fontBold, titleSize, 0, project.Title, 0)
+				if meta := joinNonEmpty(" · ", projectMeta(project), displayURL(ProjectURL(project))); meta != "" {
+					w.paragraph(fontRegular, metaSize, 0, meta, mutedGray)
+				}
+				if description := strings.TrimSpace(project.Description); description != "" {
+					w.gap(2)
+					w.paragraph(fontRegular, bodySize, 0, description, 0)
+				}
+				if tools := toolNames(project); len(tools) > 0 {
+					w.paragraph(fontRegular, metaSize, 0, "Tools: "+strings.Join(tools, ", "), mutedGray)
+				}
+			}
+		}
+	}
+
+	return w.output(), w.missingChars()
+}
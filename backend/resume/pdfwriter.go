@@ -0,0 +1,375 @@
+package resume
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// Minimal PDF writer - harici kütüphane yok
+// Sadece standart 14 fonttan Helvetica / Helvetica-Bold (gömülmez, her PDF okuyucuda var)
+// Metin WinAnsiEncoding ile yazılır; WinAnsi'de olmayan ama Helvetica'da bulunan harfler
+// (ğ, ş, ı, İ...) /Differences ile boş kontrol kodlarına atanır
+// Fontta hiç olmayan karakterler '?' olarak yazılır ve missing'e eklenir
+
+// A4, point cinsinden
+const (
+	pageWidth    = 595.28
+	pageHeight   = 841.89
+	pageMargin   = 50.0
+	contentWidth = pageWidth - 2*pageMargin
+)
+
+// pdfFont - Resource adı ve karakter genişlikleri
+type pdfFont struct {
+	resource string // "F1"
+	name     string // "Helvetica"
+	widths   []int  // 32-126 arası, 1000 birim em
+}
+
+var (
+	fontRegular = &pdfFont{resource: "F1", name: "Helvetica", widths: helveticaWidths}
+	fontBold    = &pdfFont{resource: "F2", name: "Helvetica-Bold", widths: helveticaBoldWidths}
+)
+
+// Adobe AFM dosyalarından, karakter 32 (space) - 126 (~)
+var helveticaWidths = []int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = []int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
+
+// winAnsiSpecial - Latin-1 dışında kalan ve WinAnsi'de bulunan karakterler
+var winAnsiSpecial = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// specialWidths - winAnsiSpecial karakterlerinin genişliği (iki fontta aynı)
+var specialWidths = map[byte]int{
+	0x80: 556, 0x85: 1000, 0x91: 222, 0x92: 222, 0x93: 333, 0x94: 333,
+	0x95: 350, 0x96: 556, 0x97: 1000, 0x99: 1000,
+}
+
+// extraGlyph - WinAnsi'de olmayan, Helvetica'nın glyph setinde olan harf
+// code: /Differences ile atanan kontrol kodu (9-13 wrap'te boşluk sayıldığı için kullanılmaz)
+// base: genişliği aynı olan ASCII harf (aksanlar genişliği değiştirmez)
+type extraGlyph struct {
+	code byte
+	name string
+	base byte
+}
+
+var extraGlyphs = map[rune]extraGlyph{
+	'Ğ': {1, "Gbreve", 'G'}, 'ğ': {2, "gbreve", 'g'},
+	'Ş': {3, "Scedilla", 'S'}, 'ş': {4, "scedilla", 's'},
+	'İ': {5, "Idotaccent", 'I'}, 'ı': {6, "dotlessi", 'I'}, // dotlessi iki fontta da I genişliğinde
+	'Ő': {7, "Ohungarumlaut", 'O'}, 'ő': {8, "ohungarumlaut", 'o'},
+	'Ű': {14, "Uhungarumlaut", 'U'}, 'ű': {15, "uhungarumlaut", 'u'},
+	'Ł': {16, "Lslash", 'L'}, 'ł': {17, "lslash", 'l'},
+	'Č': {18, "Ccaron", 'C'}, 'č': {19, "ccaron", 'c'},
+	'Ć': {20, "Cacute", 'C'}, 'ć': {21, "cacute", 'c'},
+	'Ř': {22, "Rcaron", 'R'}, 'ř': {23, "rcaron", 'r'},
+	'Ę': {24, "Eogonek", 'E'}, 'ę': {25, "eogonek", 'e'},
+	'Ą': {26, "Aogonek", 'A'}, 'ą': {27, "aogonek", 'a'},
+	'Ń': {28, "Nacute", 'N'}, 'ń': {29, "nacute", 'n'},
+}
+
+// extraBases - Kod -> genişlik için temel harf
+var extraBases = func() map[byte]byte {
+	bases := make(map[byte]byte, len(extraGlyphs))
+	for _, glyph := range extraGlyphs {
+		bases[glyph.code] = glyph.base
+	}
+	return bases
+}()
+
+// fontEncoding - Font dictionary'sindeki /Encoding (WinAnsi + extraGlyphs)
+func fontEncoding() string {
+	glyphs := make([]extraGlyph, 0, len(extraGlyphs))
+	for _, glyph := range extraGlyphs {
+		glyphs = append(glyphs, glyph)
+	}
+	sort.Slice(glyphs, func(i, j int) bool { return glyphs[i].code < glyphs[j].code })
+
+	var differences strings.Builder
+	for _, glyph := range glyphs {
+		fmt.Fprintf(&differences, " %d /%s", glyph.code, glyph.name)
+	}
+	return "<< /Type /Encoding /BaseEncoding /WinAnsiEncoding /Differences [" + differences.String() + " ] >>"
+}
+
+// latin1Letters - 0xC0-0xFF arası harflerin genişlik hesabı için temel harfi (ç -> c)
+const latin1Letters = "AAAAAAACEEEEIIIIDNOOOOOxOUUUUYTsaaaaaaaceeeeiiiidnooooo/ouuuuyty"
+
+// encodeWinAnsi - UTF-8 metni font encoding'inin byte'larına çevir
+// Fontta olmayan karakterler '?' olur ve missing'e eklenir (nil ise sadece çevrilir)
+func encodeWinAnsi(text string, missing map[rune]bool) []byte {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			encoded = append(encoded, ' ')
+		case r >= 32 && r <= 126:
+			encoded = append(encoded, byte(r))
+		case r >= 0xA0 && r <= 0xFF:
+			encoded = append(encoded, byte(r))
+		default:
+			if b, ok := winAnsiSpecial[r]; ok {
+				encoded = append(encoded, b)
+			} else if glyph, ok := extraGlyphs[r]; ok {
+				encoded = append(encoded, glyph.code)
+			} else if r >= 32 {
+				encoded = append(encoded, '?')
+				if missing != nil {
+					missing[r] = true
+				}
+			}
+		}
+	}
+	return encoded
+}
+
+// charWidth - WinAnsi byte'ının genişliği (1000 birim em)
+func (f *pdfFont) charWidth(b byte) int {
+	switch {
+	case b >= 32 && b <= 126:
+		return f.widths[b-32]
+	case b >= 0xC0:
+		return f.charWidth(latin1Letters[b-0xC0])
+	case b >= 0xA0:
+		return 556
+	}
+	if base, ok := extraBases[b]; ok {
+		return f.charWidth(base)
+	}
+	if width, ok := specialWidths[b]; ok {
+		return width
+	}
+	return 556
+}
+
+// textWidth - Metnin verilen puntoda genişliği
+func (f *pdfFont) textWidth(text []byte, size float64) float64 {
+	total := 0
+	for _, b := range text {
+		total += f.charWidth(b)
+	}
+	return float64(total) * size / 1000
+}
+
+// wrap - Metni genişliğe sığan satırlara böl (tek kelime sığmıyorsa karakterden bölünür)
+func (f *pdfFont) wrap(text []byte, size, width float64) [][]byte {
+	var lines [][]byte
+	var line []byte
+	for _, word := range bytes.Fields(text) {
+		candidate := word
+		if len(line) > 0 {
+			candidate = append(append(append([]byte{}, line...), ' '), word...)
+		}
+		if f.textWidth(candidate, size) <= width {
+			line = candidate
+			continue
+		}
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+		// Uzun URL vs: sığdığı kadar parçala
+		for f.textWidth(word, size) > width {
+			cut := 1
+			for cut < len(word) && f.textWidth(word[:cut+1], size) <= width {
+				cut++
+			}
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// pdfWriter - Yukarıdan aşağı akan basit sayfa düzeni
+type pdfWriter struct {
+	title   string
+	pages   []*bytes.Buffer
+	page    *bytes.Buffer
+	y       float64       // Sonraki satırın üst kenarı
+	missing map[rune]bool // Fontta olmadığı için '?' yazılan karakterler
+}
+
+func newPDFWriter(title string) *pdfWriter {
+	w := &pdfWriter{title: title, missing: make(map[rune]bool)}
+	w.newPage()
+	return w
+}
+
+func (w *pdfWriter) newPage() {
+	w.page = &bytes.Buffer{}
+	w.pages = append(w.pages, w.page)
+	w.y = pageHeight - pageMargin
+}
+
+// ensureSpace - Yükseklik sayfaya sığmıyorsa yeni sayfa
+func (w *pdfWriter) ensureSpace(height float64) {
+	if w.y-height < pageMargin {
+		w.newPage()
+	}
+}
+
+// gap - Dikey boşluk (sayfa sonunda yeni sayfaya taşmaz)
+func (w *pdfWriter) gap(height float64) {
+	w.y -= height
+}
+
+// line - Tek satır metin, x sol kenardan itibaren, gray 0 (siyah) - 1 (beyaz)
+func (w *pdfWriter) line(font *pdfFont, size, x float64, text []byte, gray float64) {
+	lineHeight := size * 1.35
+	w.ensureSpace(lineHeight)
+	w.y -= lineHeight
+	// Baseline, satır yüksekliğinin altından biraz yukarıda
+	baseline := w.y + (lineHeight-size)/2 + size*0.2
+	fmt.Fprintf(w.page, "BT %.3f g /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n",
+		gray, font.resource, size, pageMargin+x, baseline, escapePDFString(text))
+}
+
+// paragraph - Metni sarmalayarak yaz, indent kadar içeriden
+func (w *pdfWriter) paragraph(font *pdfFont, size, indent float64, text string, gray float64) {
+	for _, line := range font.wrap(encodeWinAnsi(text, w.missing), size, contentWidth-indent) {
+		w.line(font, size, indent, line, gray)
+	}
+}
+
+// bullet - "• metin", sonraki satırlar metin hizasında
+func (w *pdfWriter) bullet(font *pdfFont, size, indent float64, text string) {
+	bulletWidth := font.textWidth([]byte{0x95, ' '}, size)
+	lines := font.wrap(encodeWinAnsi(text, w.missing), size, contentWidth-indent-bulletWidth)
+	for i, line := range lines {
+		if i == 0 {
+			line = append([]byte{0x95, ' '}, line...)
+			w.line(font, size, indent, line, 0)
+			continue
+		}
+		w.line(font, size, indent+bulletWidth, line, 0)
+	}
+}
+
+// rule - Tam genişlikte ince yatay çizgi
+func (w *pdfWriter) rule() {
+	w.ensureSpace(4)
+	w.y -= 2
+	fmt.Fprintf(w.page, "0.7 G 0.5 w %.2f %.2f m %.2f %.2f l S\n",
+		pageMargin, w.y, pageWidth-pageMargin, w.y)
+	w.y -= 2
+}
+
+// output - Sayfaları tek PDF dosyası olarak birleştir
+// Obje düzeni: 1 catalog, 2 pages, 3-4 fontlar, 5 info, sonra her sayfa için page + content
+func (w *pdfWriter) output() []byte {
+	var out bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	kids := make([]string, len(w.pages))
+	for i := range w.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+i*2)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(w.pages)))
+	for _, font := range []*pdfFont{fontRegular, fontBold} {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding %s >>", font.name, fontEncoding()))
+	}
+	object(fmt.Sprintf("<< /Title %s /Producer (portfolio-backend) /CreationDate (D:%s) >>",
+		pdfTextString(w.title), time.Now().UTC().Format("20060102150405Z")))
+
+	for i, page := range w.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 7+i*2))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+// missingChars - '?' olarak yazılan karakterler, sıralı
+func (w *pdfWriter) missingChars() []rune {
+	chars := make([]rune, 0, len(w.missing))
+	for r := range w.missing {
+		chars = append(chars, r)
+	}
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+	return chars
+}
+
+// pdfTextString - Info dictionary string'i (font encoding'i değil PDFDocEncoding/UTF-16 kullanılır)
+// ASCII dışı karakter varsa BOM'lu UTF-16BE hex string yazılır
+func pdfTextString(text string) string {
+	ascii := true
+	for _, r := range text {
+		if r < 32 || r > 126 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return "(" + escapePDFString([]byte(text)) + ")"
+	}
+
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, unit := range utf16.Encode([]rune(text)) {
+		fmt.Fprintf(&b, "%04X", unit)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+// escapePDFString - Literal string içinde \ ( ) ve ASCII dışı byte'lar
+func escapePDFString(text []byte) string {
+	var b strings.Builder
+	for _, c := range text {
+		switch {
+		case c == '\\' || c == '(' || c == ')':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 32 || c > 126:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,76 @@
+package resume
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeWinAnsi(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    []byte
+		missing []rune
+	}{
+		{text: "Plain ASCII", want: []byte("Plain ASCII")},
+		{text: "Çalışkan", want: []byte{0xC7, 'a', 'l', 6, 4, 'k', 'a', 'n'}},
+		{text: "Ursavaş", want: []byte{'U', 'r', 's', 'a', 'v', 'a', 4}},
+		{text: "İĞDIR ığdır", want: []byte{5, 1, 'D', 'I', 'R', ' ', 6, 2, 'd', 6, 'r'}},
+		{text: "Łódź", want: []byte{16, 0xF3, 'd', '?'}, missing: []rune{'ź'}},
+		{text: "tab\there – “quoted”", want: []byte{'t', 'a', 'b', ' ', 'h', 'e', 'r', 'e', ' ', 0x96, ' ', 0x93, 'q', 'u', 'o', 't', 'e', 'd', 0x94}},
+		{text: "日本", want: []byte("??"), missing: []rune{'日', '本'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			w := newPDFWriter("")
+			if got := encodeWinAnsi(tt.text, w.missing); !bytes.Equal(got, tt.want) {
+				t.Errorf("encodeWinAnsi(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+			if got := w.missingChars(); len(got) != 0 || len(tt.missing) != 0 {
+				if !reflect.DeepEqual(got, tt.missing) {
+					t.Errorf("missing = %q, want %q", string(got), string(tt.missing))
+				}
+			}
+		})
+	}
+}
+
+func TestExtraGlyphWidths(t *testing.T) {
+	// Aksanlı harf temel harfle aynı genişlikte, ı ise iki fontta da I genişliğinde
+	tests := []struct {
+		font *pdfFont
+		text string
+		like string
+	}{
+		{fontRegular, "ş", "s"},
+		{fontBold, "ş", "s"},
+		{fontRegular, "Ğ", "G"},
+		{fontRegular, "ı", "I"},
+		{fontBold, "ı", "I"},
+	}
+
+	for _, tt := range tests {
+		got := tt.font.textWidth(encodeWinAnsi(tt.text, nil), 10)
+		want := tt.font.textWidth([]byte(tt.like), 10)
+		if got != want {
+			t.Errorf("%s width of %q = %v, want %v", tt.font.name, tt.text, got, want)
+		}
+	}
+}
+
+func TestPDFTextString(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Jane (Doe)", `(Jane \(Doe\))`},
+		{"Ursavaş", "<FEFF005500720073006100760061015F>"},
+	}
+
+	for _, tt := range tests {
+		if got := pdfTextString(tt.text); got != tt.want {
+			t.Errorf("pdfTextString(%q) = %s, want %s", tt.text, got, tt.want)
+		}
+	}
+}
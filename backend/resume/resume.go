@@ -0,0 +1,259 @@
+package resume
+
+import (
+	"strings"
+	"time"
+
+	"portfolio-backend/markdown"
+	"portfolio-backend/models"
+)
+
+// Resume - Ayarlar ve portfolio verisinden oluşturulmuş CV
+// Sadece açık section'lar, ayarlardaki sırayla; tüm formatlar bunu render eder
+type Resume struct {
+	Profile     models.ResumeProfile
+	Sections    []Section
+	GeneratedAt time.Time
+}
+
+// Section - Render edilecek tek section (Key'e göre ilgili alan dolu)
+// Entries: experience, education ve certifications section'ları
+type Section struct {
+	Key      string
+	Title    string
+	Summary  string
+	Entries  []models.ResumeExperience
+	Skills   []SkillGroup
+	Projects []models.Project
+}
+
+// SkillGroup - Bir kategorideki skill'ler
+type SkillGroup struct {
+	Category string
+	Skills   []models.Skill
+}
+
+// Build - CV'yi oluştur
+// skills görünüm sırasında (GetSortedSkills), projects manuel sırada (GetSortedProjects),
+// timeline en yeni önce (GetEntries) gelmeli
+// İçeriği boş kalan section'lar çıktıya eklenmez
+func Build(settings *models.ResumeSettings, skills []models.Skill, projects []models.Project, timeline []models.TimelineEntry) *Resume {
+	resume := &Resume{
+		Profile:     settings.Profile,
+		GeneratedAt: time.Now(),
+	}
+
+	for _, config := range settings.Sections {
+		if !config.Enabled {
+			continue
+		}
+
+		section := Section{Key: config.Key, Title: config.Title}
+		switch config.Key {
+		case models.ResumeSectionSummary:
+			section.Summary = strings.TrimSpace(settings.Profile.Summary)
+			if section.Summary == "" {
+				continue
+			}
+		case models.ResumeSectionExperience:
+			experience := timelineEntries(timeline, models.TimelineTypeWork)
+			if len(experience) == 0 {
+				experience = settings.SortedExperience()
+			}
+			section.Entries = limit(experience, config.Limit)
+			if len(section.Entries) == 0 {
+				continue
+			}
+		case models.ResumeSectionEducation:
+			section.Entries = limit(timelineEntries(timeline, models.TimelineTypeEducation), config.Limit)
+			if len(section.Entries) == 0 {
+				continue
+			}
+		case models.ResumeSectionCertifications:
+			section.Entries = limit(timelineEntries(timeline, models.TimelineTypeCertification), config.Limit)
+			if len(section.Entries) == 0 {
+				continue
+			}
+		case models.ResumeSectionSkills:
+			section.Skills = limit(groupSkills(skills, config.Include), config.Limit)
+			if len(section.Skills) == 0 {
+				continue
+			}
+		case models.ResumeSectionProjects:
+			section.Projects = limit(selectProjects(projects, config.Include), config.Limit)
+			if len(section.Projects) == 0 {
+				continue
+			}
+		default:
+			continue
+		}
+		resume.Sections = append(resume.Sections, section)
+	}
+
+	return resume
+}
+
+// timelineEntries - Türdeki timeline entry'lerini CV kaydına çevir
+// Açıklamadaki liste maddeleri highlight olur, kalan satırlar düz metin özet
+func timelineEntries(timeline []models.TimelineEntry, entryType string) []models.ResumeExperience {
+	var entries []models.ResumeExperience
+	for _, entry := range timeline {
+		if entry.Type != entryType {
+			continue
+		}
+		summary, highlights := splitDescription(entry.Description)
+		entries = append(entries, models.ResumeExperience{
+			Organization: entry.Organization,
+			Position:     entry.Role,
+			URL:          entry.URL,
+			Location:     entry.Location,
+			Start:        entry.Start,
+			End:          entry.End,
+			Summary:      summary,
+			Highlights:   highlights,
+		})
+	}
+	return entries
+}
+
+// splitDescription - Markdown açıklamayı özet paragraf ve madde listesine ayır
+func splitDescription(description string) (string, []string) {
+	var paragraphs, highlights []string
+	for _, line := range strings.Split(markdown.PlainText(description), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "- "), strings.HasPrefix(line, "* "), strings.HasPrefix(line, "+ "):
+			highlights = append(highlights, strings.TrimSpace(line[2:]))
+		default:
+			paragraphs = append(paragraphs, line)
+		}
+	}
+	return strings.Join(paragraphs, " "), highlights
+}
+
+// groupSkills - Skill'leri geldikleri sırayı koruyarak kategorilere ayır
+// categories boş değilse sadece o kategoriler, o sırayla
+func groupSkills(skills []models.Skill, categories []string) []SkillGroup {
+	var groups []SkillGroup
+	index := make(map[string]int)
+	for _, skill := range skills {
+		i, ok := index[skill.Category]
+		if !ok {
+			i = len(groups)
+			index[skill.Category] = i
+			groups = append(groups, SkillGroup{Category: skill.Category})
+		}
+		groups[i].Skills = append(groups[i].Skills, skill)
+	}
+
+	if len(categories) == 0 {
+		return groups
+	}
+	selected := make([]SkillGroup, 0, len(categories))
+	for _, category := range categories {
+		if i, ok := index[category]; ok {
+			selected = append(selected, groups[i])
+		}
+	}
+	return selected
+}
+
+// selectProjects - include boş değilse sadece o projeler (ID veya slug), o sırayla
+// Silinmiş projeler sessizce atlanır
+func selectProjects(projects []models.Project, include []string) []models.Project {
+	if len(include) == 0 {
+		return projects
+	}
+	selected := make([]models.Project, 0, len(include))
+	for _, ref := range include {
+		for _, project := range projects {
+			if project.ID == ref || (project.Slug != "" && project.Slug == ref) {
+				selected = append(selected, project)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// limit - İlk n eleman (0 = hepsi)
+func limit[T any](items []T, n int) []T {
+	if n > 0 && len(items) > n {
+		return items[:n]
+	}
+	return items
+}
+
+// ProjectURL - Proje için gösterilecek link (live, V1 link, repo)
+func ProjectURL(project models.Project) string {
+	if project.LiveURL != "" {
+		return project.LiveURL
+	}
+	if project.Link != "" {
+		return project.Link
+	}
+	return project.RepoURL
+}
+
+// FormatDate - "2021-03" / "2021-03-15" -> "Mar 2021", boşsa "Present"
+func FormatDate(value string) string {
+	if value == "" {
+		return "Present"
+	}
+	for _, layout := range []string{"2006-01", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format("Jan 2006")
+		}
+	}
+	return value
+}
+
+// FormatRange - "Mar 2021 – Present"
+func FormatRange(start, end string) string {
+	if start == "" {
+		return ""
+	}
+	return FormatDate(start) + " – " + FormatDate(end)
+}
+
+// EntryDates - Section'a göre tarih: sertifikalarda alınma tarihi ve varsa geçerlilik sonu
+func EntryDates(sectionKey string, entry models.ResumeExperience) string {
+	if sectionKey != models.ResumeSectionCertifications {
+		return FormatRange(entry.Start, entry.End)
+	}
+	if entry.End != "" {
+		return FormatDate(entry.Start) + " (valid until " + FormatDate(entry.End) + ")"
+	}
+	return FormatDate(entry.Start)
+}
+
+// Location - "Istanbul, TR"
+func Location(location models.ResumeLocation) string {
+	var parts []string
+	for _, part := range []string{location.City, location.Region, location.CountryCode} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// toolNames - Projede kullanılan tool isimleri
+func toolNames(project models.Project) []string {
+	names := make([]string, 0, len(project.Tools))
+	for _, tool := range project.Tools {
+		names = append(names, tool.Skill)
+	}
+	return names
+}
+
+// skillNames - Gruptaki skill isimleri
+func skillNames(group SkillGroup) []string {
+	names := make([]string, 0, len(group.Skills))
+	for _, skill := range group.Skills {
+		names = append(names, skill.Skill)
+	}
+	return names
+}
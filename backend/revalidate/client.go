@@ -0,0 +1,156 @@
+package revalidate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/events"
+)
+
+// HeaderSecret - Next.js route handler'ı bu header'ı REVALIDATE_SECRET ile karşılaştırır
+const HeaderSecret = "X-Revalidate-Secret"
+
+// dedupeWindow - Aynı path seti bu süre içinde tekrar gelirse gönderilmez
+// Örn. draft yayınlanınca PostUpdated ve PostPublished aynı anda yayınlanır, ikisi de aynı sayfaları ister
+const dedupeWindow = 2 * time.Second
+
+// Request - Revalidation endpoint'ine gönderilen body
+type Request struct {
+	Paths []string `json:"paths"`
+	Event string   `json:"event,omitempty"`
+}
+
+// Client - Next.js on-demand revalidation çağrıları
+type Client struct {
+	url         string
+	secret      string
+	client      *http.Client
+	maxAttempts int
+	retryBase   time.Duration
+
+	mu     sync.Mutex
+	recent map[string]time.Time // path seti -> son gönderim zamanı
+}
+
+// NewClient - Config'ten client oluştur
+func NewClient(cfg *config.Config) *Client {
+	timeout, err := time.ParseDuration(cfg.Revalidate.Timeout)
+	if err != nil {
+		timeout = 5 * time.Second
+	}
+
+	retryBase, err := time.ParseDuration(cfg.Revalidate.RetryBase)
+	if err != nil {
+		retryBase = time.Second
+	}
+
+	maxAttempts := cfg.Revalidate.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return &Client{
+		url:         cfg.Revalidate.URL,
+		secret:      cfg.Revalidate.Secret,
+		client:      &http.Client{Timeout: timeout},
+		maxAttempts: maxAttempts,
+		retryBase:   retryBase,
+		recent:      make(map[string]time.Time),
+	}
+}
+
+// Enabled - REVALIDATE_URL tanımlı mı?
+func (c *Client) Enabled() bool {
+	return c.url != ""
+}
+
+// HandleEvent - events.Subscribe ile kayıt edilir
+func (c *Client) HandleEvent(event events.Event) {
+	if !c.Enabled() {
+		return
+	}
+
+	paths := Paths(event)
+	if len(paths) == 0 || c.isDuplicate(paths) {
+		return
+	}
+
+	if err := c.Revalidate(event.Name, paths); err != nil {
+		log.Printf("Revalidate: %s %v failed: %v", event.Name, paths, err)
+	}
+}
+
+// isDuplicate - Aynı path seti dedupeWindow içinde zaten gönderildi mi? Değilse şimdi gönderilmiş sayılır
+// Event handler'ları ayrı goroutine'lerde çalışır, ilk gelen gönderir, diğerleri atlanır
+func (c *Client) isDuplicate(paths []string) bool {
+	key := strings.Join(paths, "\n")
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, sentAt := range c.recent {
+		if now.Sub(sentAt) >= dedupeWindow {
+			delete(c.recent, k)
+		}
+	}
+	if _, ok := c.recent[key]; ok {
+		return true
+	}
+	c.recent[key] = now
+	return false
+}
+
+// Revalidate - Path'leri revalidate et, başarısız olursa exponential backoff ile tekrar dene
+func (c *Client) Revalidate(eventName string, paths []string) error {
+	body, err := json.Marshal(Request{Paths: paths, Event: eventName})
+	if err != nil {
+		return fmt.Errorf("failed to marshal revalidate request: %w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = c.post(body)
+		if err == nil {
+			log.Printf("Revalidate: %v revalidated (%s)", paths, eventName)
+			return nil
+		}
+
+		if attempt >= c.maxAttempts {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		}
+
+		time.Sleep(c.retryBase * time.Duration(1<<(attempt-1)))
+	}
+}
+
+// post - Tek HTTP denemesi; 2xx dışındaki cevaplar hata sayılır
+func (c *Client) post(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSecret, c.secret)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package revalidate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+)
+
+// stubServer - Gelen revalidate isteklerini kaydeder, ilk failures isteğe 500 döner
+type stubServer struct {
+	mu       sync.Mutex
+	failures int
+	secrets  []string
+	requests []Request
+}
+
+func (s *stubServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.secrets = append(s.secrets, r.Header.Get(HeaderSecret))
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.requests = append(s.requests, req)
+
+	if s.failures > 0 {
+		s.failures--
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func newTestClient(url string, maxAttempts int) *Client {
+	return &Client{
+		url:         url,
+		secret:      "test-secret",
+		client:      &http.Client{Timeout: time.Second},
+		maxAttempts: maxAttempts,
+		retryBase:   time.Millisecond,
+		recent:      make(map[string]time.Time),
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	post := &models.BlogPost{Slug: "hello-world"}
+	postPaths := []string{PathBlog, PathBlog + "/hello-world"}
+
+	tests := []struct {
+		name     string
+		events   []string
+		failures int
+		attempts int
+		want     [][]string // sunucuya ulaşan path setleri (retry'lar dahil)
+	}{
+		{
+			name:     "single event",
+			events:   []string{events.PostCreated},
+			attempts: 1,
+			want:     [][]string{postPaths},
+		},
+		{
+			name:     "publishing an edited draft revalidates once",
+			events:   []string{events.PostUpdated, events.PostPublished},
+			attempts: 1,
+			want:     [][]string{postPaths},
+		},
+		{
+			name:     "different path sets are both sent",
+			events:   []string{events.PostUpdated, events.ProjectUpdated},
+			attempts: 1,
+			want:     [][]string{postPaths, {PathHome, PathWorks}},
+		},
+		{
+			name:     "retries until success",
+			events:   []string{events.PostUpdated},
+			failures: 2,
+			attempts: 3,
+			want:     [][]string{postPaths, postPaths, postPaths},
+		},
+		{
+			name:     "gives up after max attempts",
+			events:   []string{events.PostUpdated},
+			failures: 5,
+			attempts: 2,
+			want:     [][]string{postPaths, postPaths},
+		},
+		{
+			name:     "events without pages are ignored",
+			events:   []string{events.TestimonialSubmitted},
+			attempts: 1,
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubServer{failures: tt.failures}
+			server := httptest.NewServer(stub)
+			defer server.Close()
+
+			client := newTestClient(server.URL, tt.attempts)
+			// Publish gibi sıralı değil, aynı anda geliyormuş gibi
+			var wg sync.WaitGroup
+			for _, name := range tt.events {
+				wg.Add(1)
+				go func(name string) {
+					defer wg.Done()
+					client.HandleEvent(events.Event{Name: name, Data: post})
+				}(name)
+			}
+			wg.Wait()
+
+			stub.mu.Lock()
+			defer stub.mu.Unlock()
+
+			var got [][]string
+			for _, req := range stub.requests {
+				got = append(got, req.Paths)
+			}
+			if !samePathSets(got, tt.want) {
+				t.Errorf("paths = %v, want %v", got, tt.want)
+			}
+			for i, secret := range stub.secrets {
+				if secret != "test-secret" {
+					t.Errorf("request %d: %s = %q, want %q", i, HeaderSecret, secret, "test-secret")
+				}
+			}
+		})
+	}
+}
+
+func TestHandleEventDisabled(t *testing.T) {
+	client := newTestClient("", 1)
+	// URL yoksa hiçbir şey gönderilmez ve dedupe kaydı tutulmaz
+	client.HandleEvent(events.Event{Name: events.PostUpdated, Data: &models.BlogPost{Slug: "x"}})
+	if len(client.recent) != 0 {
+		t.Errorf("recent = %v, want empty", client.recent)
+	}
+}
+
+// samePathSets - Sıradan bağımsız karşılaştırma (farklı event'ler ayrı goroutine'lerde gönderilir)
+func samePathSets(got, want [][]string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	used := make([]bool, len(want))
+	for _, g := range got {
+		found := false
+		for i, w := range want {
+			if !used[i] && reflect.DeepEqual(g, w) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package revalidate
+
+import (
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+)
+
+// Public site sayfaları (frontend src/app altındaki route'lar)
+const (
+	PathHome  = "/"
+	PathBlog  = "/blog"
+	PathWorks = "/works"
+	PathAbout = "/about"
+)
+
+// Paths - Event'ten etkilenen sayfalar
+// Home sayfası projeleri ve skill'leri gösterir, blog'u göstermez
+func Paths(event events.Event) []string {
+	switch event.Name {
+	case events.PostCreated, events.PostUpdated, events.PostPublished,
+		events.PostUnpublished, events.PostDeleted:
+		paths := []string{PathBlog}
+		if slug := postSlug(event.Data); slug != "" {
+			paths = append(paths, PathBlog+"/"+slug)
+		}
+		return paths
+
+	case events.ProjectCreated, events.ProjectUpdated, events.ProjectDeleted, events.ProjectsReordered,
+		events.ProjectStatusesUpdated:
+		return []string{PathHome, PathWorks}
+
+	case events.SkillCreated, events.SkillUpdated, events.SkillDeleted, events.SkillsReordered:
+		// Proje kartları tool icon'larını da gösterir
+		return []string{PathHome, PathAbout, PathWorks}
+
+	case events.TimelineCreated, events.TimelineUpdated, events.TimelineDeleted:
+		return []string{PathAbout}
+
+	case events.TestimonialUpdated, events.TestimonialDeleted:
+		// Pending testimonial'lar (TestimonialSubmitted) sitede görünmez
+		return []string{PathHome, PathAbout}
+	}
+
+	return nil
+}
+
+// postSlug - Event data'sından blog slug'ını çıkar
+func postSlug(data interface{}) string {
+	switch post := data.(type) {
+	case *models.BlogPost:
+		if post != nil {
+			return post.Slug
+		}
+	case models.BlogPost:
+		return post.Slug
+	}
+	return ""
+}
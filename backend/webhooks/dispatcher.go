@@ -0,0 +1,225 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"portfolio-backend/config"
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Gönderim header'ları - alıcı imzayı X-Webhook-Signature ile doğrular
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Payload - Webhook body formatı
+type Payload struct {
+	ID        string      `json:"id"` // Event ID (redeliver'da aynı kalır)
+	Event     string      `json:"event"`
+	Timestamp time.Time   `json:"timestamp"`
+	Data      interface{} `json:"data"`
+}
+
+// Dispatcher - Content event'lerini kayıtlı webhook'lara iletir
+type Dispatcher struct {
+	webhooksRepo *models.WebhooksRepository
+	client       *http.Client
+	maxAttempts  int
+	retryBase    time.Duration
+	inflight     sync.WaitGroup
+}
+
+// NewDispatcher - Yeni dispatcher oluştur
+func NewDispatcher(cfg *config.Config, redisClient *redis.Client) *Dispatcher {
+	timeout, err := time.ParseDuration(cfg.Webhooks.Timeout)
+	if err != nil {
+		timeout = 10 * time.Second
+	}
+
+	retryBase, err := time.ParseDuration(cfg.Webhooks.RetryBase)
+	if err != nil {
+		retryBase = 2 * time.Second
+	}
+
+	maxAttempts := cfg.Webhooks.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return &Dispatcher{
+		webhooksRepo: models.NewWebhooksRepository(redisClient),
+		client:       &http.Client{Timeout: timeout},
+		maxAttempts:  maxAttempts,
+		retryBase:    retryBase,
+	}
+}
+
+// Repository - Admin handler'ı için repository erişimi
+func (d *Dispatcher) Repository() *models.WebhooksRepository {
+	return d.webhooksRepo
+}
+
+// HandleEvent - events.Subscribe ile kayıt edilir
+// Event'i dinleyen her aktif webhook için ayrı delivery oluşturur
+func (d *Dispatcher) HandleEvent(event events.Event) {
+	webhooks, err := d.webhooksRepo.GetWebhooksForEvent(event.Name)
+	if err != nil {
+		log.Printf("Webhooks: failed to load webhooks for %s: %v", event.Name, err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	eventID := models.NewEventID()
+	body, err := json.Marshal(Payload{
+		ID:        eventID,
+		Event:     event.Name,
+		Timestamp: event.OccurredAt,
+		Data:      event.Data,
+	})
+	if err != nil {
+		log.Printf("Webhooks: failed to marshal %s payload: %v", event.Name, err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		delivery := models.NewWebhookDelivery(webhook.ID, eventID, event.Name, string(body))
+		if err := d.webhooksRepo.SaveDelivery(delivery); err != nil {
+			log.Printf("Webhooks: failed to save delivery for %s: %v", webhook.ID, err)
+			continue
+		}
+
+		d.inflight.Add(1)
+		go d.deliver(webhook, delivery)
+	}
+}
+
+// Redeliver - Önceki bir delivery'yi aynı payload ile yeniden gönder
+// Yeni delivery kaydı oluşur, sonuç log'da ayrıca görünür
+func (d *Dispatcher) Redeliver(deliveryID string) (*models.WebhookDelivery, error) {
+	original, err := d.webhooksRepo.GetDeliveryByID(deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := d.webhooksRepo.GetWebhookByID(original.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := models.NewWebhookDelivery(webhook.ID, original.EventID, original.Event, original.Payload)
+	delivery.RedeliveryOf = original.ID
+
+	if err := d.webhooksRepo.SaveDelivery(delivery); err != nil {
+		return nil, err
+	}
+
+	d.inflight.Add(1)
+	go d.deliver(*webhook, delivery)
+
+	return delivery, nil
+}
+
+// Wait - Devam eden delivery'lerin (retry'lar dahil) bitmesini bekle
+// HandleEvent'i çağıran events.Wait'ten sonra kullanılır (V1 migrator çıkmadan önce)
+func (d *Dispatcher) Wait() {
+	d.inflight.Wait()
+}
+
+// Sign - Body'nin HMAC-SHA256 imzası ("sha256=<hex>")
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify - Alıcı tarafı için imza kontrolü (constant-time)
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Helper functions
+
+// deliver - 2xx alana kadar exponential backoff ile dene
+// Her denemeden sonra delivery log'u güncellenir
+func (d *Dispatcher) deliver(webhook models.Webhook, delivery *models.WebhookDelivery) {
+	defer d.inflight.Done()
+	body := []byte(delivery.Payload)
+	signature := Sign(webhook.Secret, body)
+
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		statusCode, err := d.post(webhook.URL, delivery, signature, body)
+
+		delivery.Attempts = attempt
+		delivery.LastStatusCode = statusCode
+		delivery.LastError = ""
+
+		if err == nil {
+			now := time.Now()
+			delivery.Status = models.DeliverySucceeded
+			delivery.DeliveredAt = &now
+			d.save(delivery)
+			return
+		}
+
+		delivery.LastError = err.Error()
+		if attempt == d.maxAttempts {
+			delivery.Status = models.DeliveryFailed
+			d.save(delivery)
+			log.Printf("Webhooks: giving up on %s after %d attempts: %v", delivery.ID, attempt, err)
+			return
+		}
+
+		d.save(delivery)
+		time.Sleep(d.retryBase * time.Duration(1<<(attempt-1)))
+	}
+}
+
+// post - Tek HTTP denemesi; 2xx dışındaki cevaplar hata sayılır
+func (d *Dispatcher) post(url string, delivery *models.WebhookDelivery, signature string, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "portfolio-webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, signature)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (d *Dispatcher) save(delivery *models.WebhookDelivery) {
+	if err := d.webhooksRepo.SaveDelivery(delivery); err != nil {
+		log.Printf("Webhooks: failed to update delivery %s: %v", delivery.ID, err)
+	}
+}
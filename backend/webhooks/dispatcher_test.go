@@ -0,0 +1,223 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"portfolio-backend/events"
+	"portfolio-backend/models"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte("The quick brown fox jumps over the lazy dog")
+	// Bilinen vektör: HMAC-SHA256("key", body)
+	const want = "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"
+
+	if got := Sign("key", body); got != want {
+		t.Fatalf("Sign = %s, want %s", got, want)
+	}
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      string
+		signature string
+		valid     bool
+	}{
+		{"valid", "key", string(body), want, true},
+		{"wrong secret", "other", string(body), want, false},
+		{"tampered body", "key", string(body) + ".", want, false},
+		{"missing prefix", "key", string(body), want[len("sha256="):], false},
+		{"uppercase hex", "key", string(body), "sha256=F7BC83F430538424B13298E6AA6FB143EF4D59A14946175997479DBC2D1A3CD8", false},
+		{"empty signature", "key", string(body), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, []byte(tt.body), tt.signature); got != tt.valid {
+				t.Errorf("Verify = %v, want %v", got, tt.valid)
+			}
+		})
+	}
+}
+
+// receiver - Test webhook alıcısı; ilk failures isteğe 500 döner
+type receiver struct {
+	mu         sync.Mutex
+	failures   int
+	bodies     [][]byte
+	signatures []string
+	events     []string
+	deliveries []string
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	rc.bodies = append(rc.bodies, body)
+	rc.signatures = append(rc.signatures, r.Header.Get(HeaderSignature))
+	rc.events = append(rc.events, r.Header.Get(HeaderEvent))
+	rc.deliveries = append(rc.deliveries, r.Header.Get(HeaderDelivery))
+
+	if rc.failures > 0 {
+		rc.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newTestDispatcher(t *testing.T, maxAttempts int) *Dispatcher {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return &Dispatcher{
+		webhooksRepo: models.NewWebhooksRepository(client),
+		client:       &http.Client{Timeout: time.Second},
+		maxAttempts:  maxAttempts,
+		retryBase:    time.Millisecond,
+	}
+}
+
+func TestHandleEventDelivery(t *testing.T) {
+	tests := []struct {
+		name        string
+		subscribed  []string
+		active      bool
+		failures    int
+		maxAttempts int
+		wantCalls   int
+		wantStatus  string // Boşsa delivery oluşmamalı
+		wantCode    int
+	}{
+		{"delivered first try", []string{events.PostPublished}, true, 0, 3, 1, models.DeliverySucceeded, http.StatusNoContent},
+		{"delivered after retries", []string{"*"}, true, 2, 3, 3, models.DeliverySucceeded, http.StatusNoContent},
+		{"gives up after max attempts", []string{events.PostPublished}, true, 5, 3, 3, models.DeliveryFailed, http.StatusServiceUnavailable},
+		{"not subscribed", []string{events.PostDeleted}, true, 0, 3, 0, "", 0},
+		{"inactive webhook", []string{"*"}, false, 0, 3, 0, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &receiver{failures: tt.failures}
+			server := httptest.NewServer(rc)
+			defer server.Close()
+
+			d := newTestDispatcher(t, tt.maxAttempts)
+			webhook := models.NewWebhook(server.URL, "s3cret", "", tt.subscribed)
+			webhook.Active = tt.active
+			if err := d.webhooksRepo.SaveWebhook(webhook); err != nil {
+				t.Fatalf("SaveWebhook: %v", err)
+			}
+
+			post := &models.BlogPost{ID: "blog:hello", Slug: "hello", Title: "Hello"}
+			d.HandleEvent(events.Event{Name: events.PostPublished, Data: post, OccurredAt: time.Now()})
+			d.Wait()
+
+			rc.mu.Lock()
+			defer rc.mu.Unlock()
+			if len(rc.bodies) != tt.wantCalls {
+				t.Fatalf("receiver got %d requests, want %d", len(rc.bodies), tt.wantCalls)
+			}
+			for i, body := range rc.bodies {
+				if !Verify("s3cret", body, rc.signatures[i]) {
+					t.Errorf("request %d: signature %q does not verify", i, rc.signatures[i])
+				}
+				if rc.events[i] != events.PostPublished {
+					t.Errorf("request %d: %s = %q", i, HeaderEvent, rc.events[i])
+				}
+				// Retry'lar aynı delivery ve aynı body ile gönderilir
+				if rc.deliveries[i] != rc.deliveries[0] || string(body) != string(rc.bodies[0]) {
+					t.Errorf("request %d differs from the first attempt", i)
+				}
+			}
+
+			deliveries, err := d.webhooksRepo.GetDeliveries(webhook.ID, 10)
+			if err != nil {
+				t.Fatalf("GetDeliveries: %v", err)
+			}
+			if tt.wantStatus == "" {
+				if len(deliveries) != 0 {
+					t.Errorf("got %d deliveries, want none", len(deliveries))
+				}
+				return
+			}
+			if len(deliveries) != 1 {
+				t.Fatalf("got %d deliveries, want 1", len(deliveries))
+			}
+			delivery := deliveries[0]
+			if delivery.Status != tt.wantStatus || delivery.Attempts != tt.wantCalls || delivery.LastStatusCode != tt.wantCode {
+				t.Errorf("delivery = %s after %d attempts (HTTP %d), want %s after %d (HTTP %d)",
+					delivery.Status, delivery.Attempts, delivery.LastStatusCode, tt.wantStatus, tt.wantCalls, tt.wantCode)
+			}
+			if rc.deliveries[0] != delivery.ID {
+				t.Errorf("%s = %q, want %q", HeaderDelivery, rc.deliveries[0], delivery.ID)
+			}
+
+			var payload Payload
+			if err := json.Unmarshal(rc.bodies[0], &payload); err != nil {
+				t.Fatalf("payload: %v", err)
+			}
+			if payload.Event != events.PostPublished || payload.ID != delivery.EventID {
+				t.Errorf("payload = %+v, want event %s with ID %s", payload, events.PostPublished, delivery.EventID)
+			}
+		})
+	}
+}
+
+func TestRedeliver(t *testing.T) {
+	rc := &receiver{failures: 1}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	d := newTestDispatcher(t, 1)
+	webhook := models.NewWebhook(server.URL, "s3cret", "", []string{"*"})
+	if err := d.webhooksRepo.SaveWebhook(webhook); err != nil {
+		t.Fatalf("SaveWebhook: %v", err)
+	}
+
+	d.HandleEvent(events.Event{Name: events.ProjectDeleted, Data: &models.Project{ID: "project:Old"}, OccurredAt: time.Now()})
+	d.Wait()
+	deliveries, err := d.webhooksRepo.GetDeliveries(webhook.ID, 10)
+	if err != nil || len(deliveries) != 1 || deliveries[0].Status != models.DeliveryFailed {
+		t.Fatalf("first delivery = %+v, %v; want one failed delivery", deliveries, err)
+	}
+	original := deliveries[0]
+
+	redelivery, err := d.Redeliver(original.ID)
+	if err != nil {
+		t.Fatalf("Redeliver: %v", err)
+	}
+	d.Wait()
+
+	saved, err := d.webhooksRepo.GetDeliveryByID(redelivery.ID)
+	if err != nil {
+		t.Fatalf("GetDeliveryByID: %v", err)
+	}
+	if saved.Status != models.DeliverySucceeded || saved.RedeliveryOf != original.ID {
+		t.Errorf("redelivery = %s (of %q), want succeeded redelivery of %s", saved.Status, saved.RedeliveryOf, original.ID)
+	}
+	// Alıcı dedupe edebilsin diye event ID ve body aynı kalır
+	if saved.EventID != original.EventID || saved.Payload != original.Payload {
+		t.Errorf("redelivery changed the event ID or payload")
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if len(rc.bodies) != 2 || string(rc.bodies[0]) != string(rc.bodies[1]) || rc.deliveries[1] != saved.ID {
+		t.Errorf("receiver got %d requests, want the same body twice with the new delivery ID", len(rc.bodies))
+	}
+}
@@ -0,0 +1,157 @@
+package wordpress
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// uploadsMarker - WordPress attachment URL'lerinde uploads klasörü
+const uploadsMarker = "/wp-content/uploads/"
+
+// resizedSuffix - WordPress'in ürettiği boyutlandırılmış kopyalar: photo-300x200.jpg
+var resizedSuffix = regexp.MustCompile(`-\d+x\d+(\.[A-Za-z0-9]+)$`)
+
+var unsafeFileChars = regexp.MustCompile(`[^a-z0-9.\-]+`)
+
+// Attachments - wp-content/uploads dosyalarını yerel klasörden blog-upload'a kopyalar
+type Attachments struct {
+	SourceDir    string `json:"-"` // Yerel wp-content/uploads kopyası ("" ise kopyalanmaz)
+	TargetDir    string `json:"-"` // ./blog-upload
+	PublicPrefix string `json:"-"` // /blog-upload/
+	DryRun       bool   `json:"-"` // Kopyalamadan hedef URL'leri hesapla
+
+	Copied  []string `json:"copied"`
+	Missing []string `json:"missing"`
+
+	done map[string]string // Kaynak URL -> public URL
+}
+
+// NewAttachments - Yeni attachment kopyalayıcı
+func NewAttachments(sourceDir, targetDir string, dryRun bool) *Attachments {
+	return &Attachments{
+		SourceDir:    sourceDir,
+		TargetDir:    targetDir,
+		PublicPrefix: "/" + filepath.Base(targetDir) + "/",
+		DryRun:       dryRun,
+		Copied:       []string{},
+		Missing:      []string{},
+		done:         make(map[string]string),
+	}
+}
+
+// Rewrite - uploads URL'i ise dosyayı kopyala ve yeni public URL'i döndür
+// Bulunamayan veya uploads dışındaki URL'ler olduğu gibi kalır
+func (a *Attachments) Rewrite(rawURL string) string {
+	if publicURL, ok := a.done[rawURL]; ok {
+		return publicURL
+	}
+
+	rel := uploadsPath(rawURL)
+	if rel == "" || a.SourceDir == "" {
+		return rawURL
+	}
+
+	// Boyutlandırılmış kopya yoksa orijinal dosya kullanılır
+	candidates := []string{rel}
+	if original := resizedSuffix.ReplaceAllString(rel, "$1"); original != rel {
+		candidates = append(candidates, original)
+	}
+
+	for _, candidate := range candidates {
+		source := filepath.Join(a.SourceDir, filepath.FromSlash(candidate))
+		if _, err := os.Stat(source); err != nil {
+			continue
+		}
+
+		name := targetName(candidate)
+		if !a.DryRun {
+			if err := copyFile(source, filepath.Join(a.TargetDir, name)); err != nil {
+				a.Missing = append(a.Missing, fmt.Sprintf("%s (%v)", candidate, err))
+				a.done[rawURL] = rawURL
+				return rawURL
+			}
+		}
+
+		publicURL := a.PublicPrefix + name
+		if !a.copied(candidate) {
+			a.Copied = append(a.Copied, candidate)
+		}
+		a.done[rawURL] = publicURL
+		return publicURL
+	}
+
+	a.Missing = append(a.Missing, rel)
+	a.done[rawURL] = rawURL
+	return rawURL
+}
+
+func (a *Attachments) copied(rel string) bool {
+	for _, existing := range a.Copied {
+		if existing == rel {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadsPath - URL'deki wp-content/uploads sonrası relative path ("" = attachment değil)
+func uploadsPath(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	index := strings.Index(parsed.Path, uploadsMarker)
+	if index == -1 {
+		return ""
+	}
+
+	rel := path.Clean(parsed.Path[index+len(uploadsMarker):])
+	if rel == "." || strings.HasPrefix(rel, "../") || rel == ".." {
+		return ""
+	}
+	return rel
+}
+
+// targetName - 2019/03/Photo 1.jpg -> wp-2019-03-photo-1.jpg (çakışmasız, düz isim)
+func targetName(rel string) string {
+	name := strings.ToLower(strings.ReplaceAll(rel, "/", "-"))
+	name = unsafeFileChars.ReplaceAllString(name, "-")
+	return "wp-" + strings.Trim(name, "-")
+}
+
+func copyFile(source, target string) error {
+	if info, err := os.Stat(target); err == nil {
+		if sourceInfo, err := os.Stat(source); err == nil && sourceInfo.Size() == info.Size() {
+			return nil // Önceki import'ta kopyalanmış
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(target)
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,164 @@
+package wordpress
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Post - BlogPost'a dönüştürülmeye hazır WordPress yazısı
+type Post struct {
+	WordPressID   int
+	Title         string
+	Slug          string
+	Content       string // Markdown
+	Excerpt       string // Boşsa blog import'undaki gibi content'ten üretilir
+	Author        string // Display name, yoksa login
+	Tags          []string
+	Published     bool
+	PublishedAt   time.Time
+	UpdatedAt     time.Time
+	FeaturedImage string
+	Permalinks    []string // Redirect kaynakları (sadece yayındaki yazılar)
+	Error         string   // Dönüştürme hatası
+}
+
+var (
+	slugUnsafe = regexp.MustCompile(`[^a-z0-9\-]+`)
+	slugDashes = regexp.MustCompile(`-+`)
+)
+
+// skippedTerms - Varsayılan WordPress kategorisi tag olarak alınmaz
+var skippedTerms = map[string]bool{"uncategorized": true}
+
+// Posts - Export'taki yazıları markdown'a dönüştür
+// Diğer item tipleri (page, attachment, nav_menu_item...) ignored'da sayılır
+func (e *Export) Posts(attachments *Attachments) ([]Post, map[string]int) {
+	authors := make(map[string]string)
+	for _, author := range e.Authors {
+		if author.DisplayName != "" {
+			authors[author.Login] = author.DisplayName
+		}
+	}
+
+	attachmentURLs := make(map[string]string)
+	for _, item := range e.Items {
+		if item.Type == TypeAttachment && item.AttachmentURL != "" {
+			attachmentURLs[strconv.Itoa(item.PostID)] = item.AttachmentURL
+		}
+	}
+
+	var posts []Post
+	ignored := make(map[string]int)
+
+	for i := range e.Items {
+		item := &e.Items[i]
+		if item.Type != TypePost || item.Status == "trash" || item.Status == "auto-draft" {
+			ignored[item.Type+"/"+item.Status]++
+			continue
+		}
+
+		post := Post{
+			WordPressID: item.PostID,
+			Title:       strings.TrimSpace(item.Title),
+			Slug:        Slugify(item.Name),
+			Author:      item.Creator,
+			Tags:        item.tags(),
+			Published:   item.Status == StatusPublish,
+			PublishedAt: item.PublishedAt(),
+			UpdatedAt:   item.ModifiedAt(),
+		}
+		if name, ok := authors[item.Creator]; ok {
+			post.Author = name
+		}
+		if post.Slug == "" {
+			post.Slug = Slugify(post.Title) // Draft'larda post_name boş olabiliyor
+		}
+		if post.UpdatedAt.IsZero() {
+			post.UpdatedAt = post.PublishedAt
+		}
+
+		converter := &Converter{RewriteURL: attachments.Rewrite}
+		content, err := converter.Convert(item.Content())
+		if err != nil {
+			post.Error = err.Error()
+		}
+		post.Content = content
+
+		if excerpt := strings.TrimSpace(item.Excerpt()); excerpt != "" {
+			plain, err := (&Converter{}).Convert(excerpt)
+			if err == nil {
+				post.Excerpt = singleLine(plain)
+			}
+		}
+
+		if thumbnail, ok := attachmentURLs[item.MetaValue("_thumbnail_id")]; ok {
+			post.FeaturedImage = attachments.Rewrite(thumbnail)
+		}
+
+		if post.Published {
+			post.Permalinks = item.permalinks()
+		}
+
+		if post.Title == "" {
+			post.Error = "post has no title"
+		} else if post.Slug == "" {
+			post.Error = "could not derive a slug"
+		}
+
+		posts = append(posts, post)
+	}
+
+	return posts, ignored
+}
+
+// tags - Kategoriler ve tag'ler tek listede (tekrarsız)
+func (i *Item) tags() []string {
+	seen := make(map[string]bool)
+	tags := []string{}
+	for _, c := range i.Categories {
+		if c.Domain != "category" && c.Domain != "post_tag" {
+			continue
+		}
+		name := strings.TrimSpace(c.Name)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] || skippedTerms[strings.ToLower(c.Nicename)] {
+			continue
+		}
+		seen[key] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
+
+// permalinks - Yazının eski URL'leri: permalink ve ?p=ID shortlink
+func (i *Item) permalinks() []string {
+	links := []string{}
+	if i.Link != "" {
+		links = append(links, i.Link)
+	}
+	if i.PostID > 0 {
+		links = append(links, fmt.Sprintf("/?p=%d", i.PostID))
+	}
+	return links
+}
+
+// Slugify - WordPress post_name'i (percent-encoded olabilir) blog slug'ına çevir
+func Slugify(value string) string {
+	if unescaped, err := url.PathUnescape(value); err == nil {
+		value = unescaped
+	}
+
+	// Türkçe karakterler ToLower'dan önce (İ -> "i̇" olmasın)
+	replacer := strings.NewReplacer(
+		"ç", "c", "ğ", "g", "ı", "i", "ö", "o", "ş", "s", "ü", "u",
+		"Ç", "c", "Ğ", "g", "İ", "i", "Ö", "o", "Ş", "s", "Ü", "u",
+	)
+	value = strings.ToLower(replacer.Replace(value))
+	value = slugUnsafe.ReplaceAllString(value, "-")
+	value = slugDashes.ReplaceAllString(value, "-")
+	return strings.Trim(value, "-")
+}
@@ -0,0 +1,383 @@
+package wordpress
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Converter - WordPress HTML içeriğini markdown'a çevirir
+// RewriteURL verilmişse img src ve link href'leri ondan geçer (attachment kopyalama için)
+type Converter struct {
+	RewriteURL func(url string) string
+
+	autop bool // Classic editor: <p> yok, boş satır paragraf, tek newline <br> sayılır
+}
+
+var (
+	captionShortcode = regexp.MustCompile(`(?s)\[caption[^\]]*\](.*?)\[/caption\]`)
+	blankLine        = regexp.MustCompile(`\n[ \t]*\n\s*`)
+	lineBreak        = regexp.MustCompile(`[ \t]*\n[ \t]*`)
+	spaces           = regexp.MustCompile(`[ \t\n]+`)
+	extraBlankLines  = regexp.MustCompile(`\n{3,}`)
+)
+
+// hardBreak - Markdown satır sonu (<br>)
+const hardBreak = "  \n"
+
+// blockElements - Paragraf sınırı oluşturan elementler
+var blockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Header: true,
+	atom.Footer: true, atom.Main: true, atom.Aside: true, atom.Figure: true, atom.Figcaption: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Ul: true, atom.Ol: true, atom.Pre: true, atom.Blockquote: true, atom.Hr: true,
+	atom.Table: true, atom.Iframe: true, atom.Dl: true,
+}
+
+// Convert - HTML'i markdown'a çevir
+func (c *Converter) Convert(content string) (string, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = captionShortcode.ReplaceAllString(content, "<figure>$1</figure>")
+	c.autop = !strings.Contains(content, "<p") && !strings.Contains(content, "<!-- wp:")
+
+	root := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(content), root)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse HTML: %w", err)
+	}
+	for _, node := range nodes {
+		root.AppendChild(node)
+	}
+
+	markdown := strings.Join(c.blocks(root), "\n\n")
+	return extraBlankLines.ReplaceAllString(markdown, "\n\n"), nil
+}
+
+// blocks - Container'ın çocuklarını markdown block'larına çevir
+// Inline çocuklar bir sonraki block'a kadar aynı paragrafta toplanır
+func (c *Converter) blocks(n *html.Node) []string {
+	var result []string
+	var inline strings.Builder
+
+	flush := func() {
+		for _, paragraph := range strings.Split(inline.String(), "\n\n") {
+			if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+				result = append(result, paragraph)
+			}
+		}
+		inline.Reset()
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && blockElements[child.DataAtom] {
+			flush()
+			if block := c.block(child); block != "" {
+				result = append(result, block)
+			}
+			continue
+		}
+		inline.WriteString(c.inline(child))
+	}
+	flush()
+
+	return result
+}
+
+// block - Tek block element
+func (c *Converter) block(n *html.Node) string {
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		return strings.Repeat("#", level) + " " + strings.TrimSpace(singleLine(c.children(n)))
+	case atom.Hr:
+		return "---"
+	case atom.Pre:
+		return codeBlock(n)
+	case atom.Blockquote:
+		return prefixLines(strings.Join(c.blocks(n), "\n\n"), "> ", ">")
+	case atom.Ul, atom.Ol:
+		return c.list(n)
+	case atom.Table:
+		return c.table(n)
+	case atom.Figcaption:
+		if caption := strings.TrimSpace(c.children(n)); caption != "" {
+			return "*" + caption + "*"
+		}
+		return ""
+	case atom.Iframe:
+		// Embed'ler (YouTube vb.) link olarak korunur
+		if src := attr(n, "src"); src != "" {
+			return fmt.Sprintf("[%s](%s)", src, src)
+		}
+		return ""
+	}
+
+	return strings.Join(c.blocks(n), "\n\n")
+}
+
+// inline - Inline node (text, vurgu, link, resim)
+func (c *Converter) inline(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return c.text(n.Data)
+	case html.ElementNode:
+	default:
+		return "" // Yorumlar (<!-- wp:paragraph -->, <!--more-->) atlanır
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Noscript:
+		return ""
+	case atom.Br:
+		return hardBreak
+	case atom.Strong, atom.B:
+		return wrap(c.children(n), "**")
+	case atom.Em, atom.I:
+		return wrap(c.children(n), "*")
+	case atom.Del, atom.S, atom.Strike:
+		return wrap(c.children(n), "~~")
+	case atom.Code, atom.Kbd:
+		text := textContent(n)
+		if strings.Contains(text, "`") {
+			return "`` " + text + " ``"
+		}
+		return "`" + text + "`"
+	case atom.A:
+		text := c.children(n)
+		href := c.rewrite(attr(n, "href"))
+		if href == "" {
+			return text
+		}
+		if strings.TrimSpace(text) == "" {
+			text = href
+		}
+		return "[" + strings.TrimSpace(text) + "](" + href + ")"
+	case atom.Img:
+		src := c.rewrite(attr(n, "src"))
+		if src == "" {
+			return ""
+		}
+		return "![" + escape(attr(n, "alt")) + "](" + src + ")"
+	}
+
+	if blockElements[n.DataAtom] {
+		// Inline bağlamda block (ör. <a><div>..</div></a>) - sadece içerik
+		return " " + singleLine(strings.Join(c.blocks(n), " ")) + " "
+	}
+	return c.children(n)
+}
+
+// children - Çocukların inline hali
+func (c *Converter) children(n *html.Node) string {
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(c.inline(child))
+	}
+	return b.String()
+}
+
+// text - Whitespace normalizasyonu ve markdown escape
+func (c *Converter) text(s string) string {
+	s = escape(s)
+	if !c.autop {
+		return spaces.ReplaceAllString(s, " ")
+	}
+
+	paragraphs := blankLine.Split(s, -1)
+	for i, paragraph := range paragraphs {
+		paragraph = lineBreak.ReplaceAllString(paragraph, "\x00")
+		paragraph = spaces.ReplaceAllString(paragraph, " ")
+		paragraphs[i] = strings.ReplaceAll(paragraph, "\x00", hardBreak)
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func (c *Converter) rewrite(url string) string {
+	url = strings.TrimSpace(url)
+	if url != "" && c.RewriteURL != nil {
+		return c.RewriteURL(url)
+	}
+	return url
+}
+
+// list - ul/ol (iç içe listeler dahil)
+func (c *Converter) list(n *html.Node) string {
+	var items []string
+	index := 1
+	for li := n.FirstChild; li != nil; li = li.NextSibling {
+		if li.Type != html.ElementNode || li.DataAtom != atom.Li {
+			continue
+		}
+
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = fmt.Sprintf("%d. ", index)
+			index++
+		}
+
+		// Devam satırları marker genişliği kadar girintilenir
+		lines := strings.Split(strings.Join(c.blocks(li), "\n"), "\n")
+		for i := 1; i < len(lines); i++ {
+			if lines[i] != "" {
+				lines[i] = strings.Repeat(" ", len(marker)) + lines[i]
+			}
+		}
+		items = append(items, marker+strings.Join(lines, "\n"))
+	}
+	return strings.Join(items, "\n")
+}
+
+// table - GFM tablo, ilk satır header
+func (c *Converter) table(n *html.Node) string {
+	var rows [][]string
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+			if child.DataAtom != atom.Tr {
+				walk(child)
+				continue
+			}
+
+			var cells []string
+			for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+				if cell.Type == html.ElementNode && (cell.DataAtom == atom.Td || cell.DataAtom == atom.Th) {
+					text := strings.TrimSpace(singleLine(c.children(cell)))
+					cells = append(cells, strings.ReplaceAll(text, "|", "\\|"))
+				}
+			}
+			rows = append(rows, cells)
+		}
+	}
+	walk(n)
+
+	if len(rows) == 0 {
+		return ""
+	}
+
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	var lines []string
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", columns))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// codeBlock - <pre> içeriği olduğu gibi, dil class'tan (language-go, brush: js)
+func codeBlock(n *html.Node) string {
+	lang := languageOf(n)
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && child.DataAtom == atom.Code && lang == "" {
+			lang = languageOf(child)
+		}
+	}
+
+	code := strings.Trim(textContent(n), "\n")
+	fence := "```"
+	if strings.Contains(code, fence) {
+		fence = "~~~"
+	}
+	return fence + lang + "\n" + code + "\n" + fence
+}
+
+func languageOf(n *html.Node) string {
+	for _, class := range strings.Fields(strings.ReplaceAll(attr(n, "class"), ";", " ")) {
+		if strings.HasPrefix(class, "language-") {
+			return strings.TrimPrefix(class, "language-")
+		}
+		if strings.HasPrefix(class, "lang-") {
+			return strings.TrimPrefix(class, "lang-")
+		}
+	}
+	// SyntaxHighlighter: class="brush: php; title: ;"
+	if fields := strings.Fields(attr(n, "class")); len(fields) > 1 && fields[0] == "brush:" {
+		return strings.TrimSuffix(fields[1], ";")
+	}
+	return ""
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(textContent(child))
+	}
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// wrap - Vurgu işaretleri boşlukların içinde kalır ("** a **" geçersiz markdown)
+func wrap(text, mark string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	leading := text[:strings.Index(text, trimmed)]
+	trailing := text[len(leading)+len(trimmed):]
+	return leading + mark + trimmed + mark + trailing
+}
+
+func singleLine(text string) string {
+	return spaces.ReplaceAllString(strings.ReplaceAll(text, hardBreak, " "), " ")
+}
+
+func prefixLines(text, prefix, emptyPrefix string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = emptyPrefix
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// escape - Markdown'da anlamı olan karakterler (kelime içindeki _ hariç: snake_case)
+func escape(text string) string {
+	var b strings.Builder
+	runes := []rune(text)
+	for i, r := range runes {
+		switch r {
+		case '\\', '*', '`', '[', ']':
+			b.WriteRune('\\')
+		case '_':
+			if i == 0 || i == len(runes)-1 || !isWordRune(runes[i-1]) || !isWordRune(runes[i+1]) {
+				b.WriteRune('\\')
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r > 127
+}
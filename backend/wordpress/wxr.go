@@ -0,0 +1,167 @@
+package wordpress
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// WordPress post type ve status'ları
+const (
+	TypePost       = "post"
+	TypeAttachment = "attachment"
+	StatusPublish  = "publish"
+)
+
+// Export - WXR (WordPress eXtended RSS) dosyası
+type Export struct {
+	Title       string
+	Link        string
+	BaseSiteURL string
+	Authors     []Author
+	Items       []Item
+}
+
+// Author - <wp:author> (dc:creator login'i display name'e çevirmek için)
+type Author struct {
+	Login       string `xml:"author_login"`
+	DisplayName string `xml:"author_display_name"`
+}
+
+// Item - WXR <item> (post, page, attachment, nav_menu_item...)
+type Item struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	GUID          string     `xml:"guid"`
+	Creator       string     `xml:"creator"`
+	PostID        int        `xml:"post_id"`
+	PostDateGMT   string     `xml:"post_date_gmt"`
+	PostDate      string     `xml:"post_date"`
+	ModifiedGMT   string     `xml:"post_modified_gmt"`
+	Name          string     `xml:"post_name"` // Slug
+	Status        string     `xml:"status"`
+	Type          string     `xml:"post_type"`
+	ParentID      int        `xml:"post_parent"`
+	AttachmentURL string     `xml:"attachment_url"`
+	Categories    []Category `xml:"category"`
+	Meta          []Meta     `xml:"postmeta"`
+	Encoded       []encoded  `xml:"encoded"` // content:encoded ve excerpt:encoded
+}
+
+// Category - <category domain="category|post_tag" nicename="go">Go</category>
+type Category struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+// Meta - <wp:postmeta>
+type Meta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// encoded - Aynı local name'li iki element namespace'e göre ayrılır
+type encoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type rss struct {
+	Channel struct {
+		Title       string   `xml:"title"`
+		Link        string   `xml:"link"`
+		BaseSiteURL string   `xml:"base_site_url"`
+		Authors     []Author `xml:"author"`
+		Items       []Item   `xml:"item"`
+	} `xml:"channel"`
+}
+
+// Parse - WXR dosyasını oku
+func Parse(r io.Reader) (*Export, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false // Eski export'larda HTML entity'leri (&nbsp; vb.) olabiliyor
+	decoder.Entity = xml.HTMLEntity
+
+	var doc rss
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse WXR: %w", err)
+	}
+
+	return &Export{
+		Title:       doc.Channel.Title,
+		Link:        doc.Channel.Link,
+		BaseSiteURL: doc.Channel.BaseSiteURL,
+		Authors:     doc.Channel.Authors,
+		Items:       doc.Channel.Items,
+	}, nil
+}
+
+// Content - content:encoded (HTML)
+func (i *Item) Content() string {
+	for _, e := range i.Encoded {
+		if strings.Contains(e.XMLName.Space, "/content/") {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+// Excerpt - excerpt:encoded
+func (i *Item) Excerpt() string {
+	for _, e := range i.Encoded {
+		if strings.Contains(e.XMLName.Space, "/excerpt/") {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+// MetaValue - postmeta değeri
+func (i *Item) MetaValue(key string) string {
+	for _, m := range i.Meta {
+		if m.Key == key {
+			return m.Value
+		}
+	}
+	return ""
+}
+
+// Terms - Verilen domain'deki kategori isimleri (category, post_tag)
+func (i *Item) Terms(domain string) []string {
+	var terms []string
+	for _, c := range i.Categories {
+		if c.Domain == domain {
+			terms = append(terms, strings.TrimSpace(c.Name))
+		}
+	}
+	return terms
+}
+
+// PublishedAt - post_date_gmt, yoksa (draft'larda 0000-00-00) yerel post_date
+func (i *Item) PublishedAt() time.Time {
+	if t, ok := parseDate(i.PostDateGMT); ok {
+		return t
+	}
+	if t, ok := parseDate(i.PostDate); ok {
+		return t
+	}
+	return time.Time{}
+}
+
+// ModifiedAt - post_modified_gmt
+func (i *Item) ModifiedAt() time.Time {
+	t, _ := parseDate(i.ModifiedGMT)
+	return t
+}
+
+func parseDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "0000") {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02 15:04:05", value)
+	return t, err == nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"portfolio-backend/config"
+	"portfolio-backend/handlers"
+)
+
+// WordPress WXR import CLI
+// go run ./wpimport -file export.xml -uploads ./wp-content/uploads [-overwrite] [-dry-run]
+func main() {
+	file := flag.String("file", "", "WordPress export (WXR) XML file")
+	uploads := flag.String("uploads", "", "Local copy of wp-content/uploads (default: WORDPRESS_UPLOADS_DIR)")
+	overwrite := flag.Bool("overwrite", false, "Overwrite posts with the same slug")
+	dryRun := flag.Bool("dry-run", false, "Show the plan without writing to Redis or disk")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	fmt.Println("🚀 WordPress Import Started")
+	fmt.Println("====================================================")
+
+	cfg := config.LoadConfig()
+	if *uploads == "" {
+		*uploads = cfg.WordPress.UploadsDir
+	}
+
+	err := config.InitRedis(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize Redis:", err)
+	}
+	defer config.CloseRedis()
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatal("Failed to open WXR file:", err)
+	}
+	defer f.Close()
+
+	handler := handlers.NewWordPressHandler(cfg, config.GetRedisClient())
+	report, err := handler.Import(f, handlers.WordPressOptions{
+		UploadsDir: *uploads,
+		Overwrite:  *overwrite,
+		DryRun:     *dryRun,
+	})
+	if err != nil {
+		log.Fatal("WordPress import failed:", err)
+	}
+
+	if report.DryRun {
+		fmt.Println("🔍 Dry run - nothing was written")
+	}
+	fmt.Printf("🌐 Site: %s\n\n", report.Site)
+
+	for _, item := range report.Items {
+		line := fmt.Sprintf("  [%s] #%d %s -> %s", item.Action, item.WordPressID, item.Title, item.Slug)
+		if item.Error != "" {
+			line += " ❌ " + item.Error
+		}
+		fmt.Println(line)
+		for _, redirect := range item.Redirects {
+			fmt.Printf("      ↪ %s\n", redirect)
+		}
+	}
+
+	fmt.Println("")
+	fmt.Println("📊 Summary:")
+	for _, action := range sortedKeys(report.Summary) {
+		fmt.Printf("  %s: %d\n", action, report.Summary[action])
+	}
+	fmt.Printf("  redirects: %d\n", report.Redirects)
+	fmt.Printf("  attachments copied: %d, missing: %d\n", len(report.Attachments.Copied), len(report.Attachments.Missing))
+	for _, missing := range report.Attachments.Missing {
+		fmt.Printf("      ⚠️  %s\n", missing)
+	}
+	for _, key := range sortedKeys(report.Ignored) {
+		fmt.Printf("  ignored %s: %d\n", key, report.Ignored[key])
+	}
+
+	fmt.Println("")
+	fmt.Println("🎉 WordPress Import Completed!")
+	fmt.Println("====================================================")
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
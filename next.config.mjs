@@ -1,6 +1,29 @@
+const API_BASE_URL = process.env.API_URL || process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8082';
+
+// Old URLs (e.g. WordPress permalinks) recorded by the backend, loaded at build time
+async function loadRedirects() {
+  try {
+    const res = await fetch(`${API_BASE_URL}/api/v1/redirects`);
+    if (!res.ok) return [];
+    const data = await res.json();
+    return (data.redirects || []).map(r => {
+      // "/?p=12" style shortlinks match on the query string
+      const [source, query] = r.from.split('?');
+      const redirect = { source, destination: r.to, permanent: true };
+      if (query) {
+        redirect.has = [...new URLSearchParams(query)].map(([key, value]) => ({ type: 'query', key, value }));
+      }
+      return redirect;
+    });
+  } catch {
+    return [];
+  }
+}
+
 /** @type {import('next').NextConfig} */
 const nextConfig = {
   trailingSlash: true,
+  redirects: loadRedirects,
   images: {
     remotePatterns: [
       {
@@ -0,0 +1,27 @@
+import { revalidatePath } from 'next/cache';
+import { NextResponse } from 'next/server';
+
+// On-demand revalidation endpoint called by the Go backend after admin edits
+// Backend: REVALIDATE_URL=http://localhost:3000/api/revalidate, REVALIDATE_SECRET must match
+export async function POST(request) {
+  const secret = request.headers.get('x-revalidate-secret');
+  if (!process.env.REVALIDATE_SECRET || secret !== process.env.REVALIDATE_SECRET) {
+    return NextResponse.json({ error: 'Invalid secret' }, { status: 401 });
+  }
+
+  let body;
+  try {
+    body = await request.json();
+  } catch {
+    return NextResponse.json({ error: 'Invalid JSON body' }, { status: 400 });
+  }
+
+  const paths = Array.isArray(body.paths) ? body.paths.filter(p => typeof p === 'string' && p.startsWith('/')) : [];
+  if (paths.length === 0) {
+    return NextResponse.json({ error: 'No paths to revalidate' }, { status: 400 });
+  }
+
+  paths.forEach(path => revalidatePath(path));
+
+  return NextResponse.json({ revalidated: true, paths, now: Date.now() });
+}